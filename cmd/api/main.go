@@ -1,22 +1,40 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
 	"log"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 	"time"
 
 	_ "github.com/GunarsK-portfolio/admin-api/docs"
+	"github.com/GunarsK-portfolio/admin-api/internal/cache"
+	"github.com/GunarsK-portfolio/admin-api/internal/certexpiry"
 	"github.com/GunarsK-portfolio/admin-api/internal/config"
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filesapi"
 	"github.com/GunarsK-portfolio/admin-api/internal/handlers"
+	"github.com/GunarsK-portfolio/admin-api/internal/notifier"
+	"github.com/GunarsK-portfolio/admin-api/internal/orphans"
+	"github.com/GunarsK-portfolio/admin-api/internal/outbox"
 	"github.com/GunarsK-portfolio/admin-api/internal/repository"
 	"github.com/GunarsK-portfolio/admin-api/internal/routes"
+	"github.com/GunarsK-portfolio/admin-api/internal/stream"
+	"github.com/GunarsK-portfolio/admin-api/internal/webhooks"
 	commondb "github.com/GunarsK-portfolio/portfolio-common/database"
 	"github.com/GunarsK-portfolio/portfolio-common/health"
 	"github.com/GunarsK-portfolio/portfolio-common/logger"
 	"github.com/GunarsK-portfolio/portfolio-common/metrics"
+	"github.com/GunarsK-portfolio/portfolio-common/queue"
 	"github.com/GunarsK-portfolio/portfolio-common/server"
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 )
 
 // @title Portfolio Admin API
@@ -69,7 +87,125 @@ func main() {
 	healthAgg.Register(health.NewPostgresChecker(db))
 
 	repo := repository.New(db, cfg.FilesAPIURL)
-	handler := handlers.New(repo)
+	// Background workers stop as soon as shutdown starts, which also ends open
+	// event streams so graceful shutdown does not wait for them
+	workerCtx, stopWorkers := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stopWorkers()
+
+	// Webhook deliveries are recorded with each change; events wake the dispatcher
+	dispatcher := webhooks.NewDispatcher(repo, webhooks.Config{
+		MaxAttempts:  cfg.Webhooks.MaxAttempts,
+		BaseDelay:    cfg.Webhooks.RetryBaseDelay,
+		MaxDelay:     cfg.Webhooks.RetryMaxDelay,
+		PollInterval: cfg.Webhooks.PollInterval,
+		Client:       &http.Client{Timeout: cfg.Webhooks.Timeout},
+	}, appLogger)
+	go dispatcher.Run(workerCtx)
+	// Live admin UI streams receive the same events
+	broker := stream.NewBroker(stream.Config{
+		BufferSize:        cfg.Stream.BufferSize,
+		HeartbeatInterval: cfg.Stream.HeartbeatInterval,
+	})
+	go broker.Run(workerCtx)
+	eventBus := events.NewBus(dispatcher, broker)
+
+	// Records created before slugs existed get one, so slug lookups find them
+	go func() {
+		backfilled, err := repo.BackfillSlugs(workerCtx)
+		if err != nil {
+			appLogger.Error("Failed to backfill slugs", "backfilled", backfilled, "error", err)
+			return
+		}
+		if backfilled > 0 {
+			appLogger.Info("Backfilled slugs", "count", backfilled)
+		}
+	}()
+
+	// files-api client, also probed by /health
+	filesClient := filesapi.NewClient(filesapi.Config{
+		BaseURL:        cfg.FilesAPIURL,
+		HealthURL:      cfg.Files.APIHealthURL,
+		Timeout:        cfg.Files.APITimeout,
+		MaxRetries:     cfg.Files.APIMaxRetries,
+		RetryBaseDelay: cfg.Files.APIRetryBaseDelay,
+		UploadTimeout:  cfg.Files.UploadTimeout,
+	})
+	healthAgg.Register(filesapi.NewHealthChecker(filesClient))
+
+	// Orphaned storage files are deleted through files-api
+	orphanScanner := orphans.NewScanner(repo, filesClient, orphans.Config{
+		GracePeriod: cfg.Files.OrphanGracePeriod,
+	}, appLogger)
+
+	handlerOpts := []handlers.Option{
+		handlers.WithCertExpiringWindow(cfg.CertExpiringWindowDays),
+		handlers.WithContentLocales(cfg.ContentLocales),
+		handlers.WithEvents(eventBus),
+		handlers.WithWebhookTester(dispatcher),
+		handlers.WithEventStream(broker),
+		handlers.WithOrphanScanner(orphanScanner),
+		handlers.WithFileUploads(filesClient, cfg.Files.UploadMaxBytes, cfg.Files.UploadTimeout),
+	}
+
+	// Content change events also invalidate the public API cache
+	if cfg.Cache.InvalidationEnabled {
+		if err := cache.ValidateKeys(cfg.Cache.Keys); err != nil {
+			appLogger.Error("Invalid cache key configuration", "error", err)
+			log.Fatal("Invalid cache key configuration:", err)
+		}
+		redisOpts := &redis.Options{
+			Addr:     net.JoinHostPort(cfg.Cache.Redis.Host, strconv.Itoa(cfg.Cache.Redis.Port)),
+			Password: cfg.Cache.Redis.Password,
+		}
+		if cfg.Cache.Redis.TLS {
+			redisOpts.TLSConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		redisClient := redis.NewClient(redisOpts)
+		defer func() {
+			if closeErr := redisClient.Close(); closeErr != nil {
+				appLogger.Error("Failed to close Redis client", "error", closeErr)
+			}
+		}()
+		healthAgg.Register(health.NewRedisChecker(redisClient))
+
+		invalidator := cache.NewInvalidator(cache.NewRedisStore(redisClient, cfg.Cache.Channel), cache.Config{
+			Keys: cfg.Cache.Keys,
+		}, appLogger)
+		go invalidator.Run(workerCtx)
+		eventBus.Subscribe(invalidator)
+		handlerOpts = append(handlerOpts, handlers.WithCachePurger(invalidator))
+	}
+
+	handler := handlers.New(repo, handlerOpts...)
+
+	if cfg.CertReminders.Enabled {
+		checker := certexpiry.NewChecker(repo, newCertReminderNotifier(cfg.CertReminders, appLogger), certexpiry.CheckerConfig{
+			Interval:   cfg.CertReminders.Interval,
+			Thresholds: cfg.CertReminders.Thresholds,
+		}, appLogger)
+		go checker.Run(workerCtx)
+	}
+
+	// Outbox relay publishes domain events written by repository mutations
+	if cfg.Outbox.RelayEnabled {
+		publisher, err := queue.NewRabbitMQPublisher(*cfg.Outbox.RabbitMQ, queue.WithPublisherLogger(appLogger))
+		if err != nil {
+			appLogger.Error("Failed to connect to RabbitMQ", "error", err)
+			log.Fatal("Failed to connect to RabbitMQ:", err)
+		}
+		defer func() {
+			if closeErr := publisher.Close(); closeErr != nil {
+				appLogger.Error("Failed to close RabbitMQ publisher", "error", closeErr)
+			}
+		}()
+		relay := outbox.NewRelay(repo, publisher, outbox.Config{
+			PollInterval: cfg.Outbox.PollInterval,
+			BatchSize:    cfg.Outbox.BatchSize,
+			Retention:    cfg.Outbox.Retention,
+		}, appLogger)
+		go relay.Run(workerCtx)
+		appLogger.Info("Outbox relay connected to RabbitMQ", "exchange", cfg.Outbox.RabbitMQ.Exchange)
+	}
 
 	router := gin.New()
 	router.Use(logger.Recovery(appLogger))
@@ -81,8 +217,31 @@ func main() {
 	appLogger.Info("Admin API ready", "port", cfg.ServiceConfig.Port, "environment", os.Getenv("ENVIRONMENT"))
 
 	serverCfg := server.DefaultConfig(strconv.Itoa(cfg.ServiceConfig.Port))
-	if err := server.Run(router, serverCfg, appLogger); err != nil {
+	if err := server.RunWithCleanup(router, serverCfg, appLogger, stopWorkers); err != nil {
 		appLogger.Error("Server error", "error", err)
 		log.Fatal("Server error:", err)
 	}
 }
+
+// newCertReminderNotifier builds the notifier fan-out for certification reminders
+func newCertReminderNotifier(cfg config.CertReminderConfig, appLogger *slog.Logger) notifier.Notifier {
+	notifiers := make(notifier.Multi, 0, len(cfg.Notifiers))
+	for _, name := range cfg.Notifiers {
+		switch name {
+		case "log":
+			notifiers = append(notifiers, notifier.NewLogNotifier(appLogger))
+		case "smtp":
+			notifiers = append(notifiers, notifier.NewSMTPNotifier(notifier.SMTPConfig{
+				Host:     cfg.SMTP.Host,
+				Port:     cfg.SMTP.Port,
+				Username: cfg.SMTP.Username,
+				Password: cfg.SMTP.Password,
+				From:     cfg.SMTP.From,
+				To:       cfg.SMTP.To,
+			}))
+		case "webhook":
+			notifiers = append(notifiers, notifier.NewWebhookNotifier(cfg.WebhookURL, &http.Client{Timeout: 10 * time.Second}))
+		}
+	}
+	return notifiers
+}
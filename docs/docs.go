@@ -15,38 +15,54 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/files/{id}": {
-            "delete": {
+        "/blog/articles": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a miniature file record (removes link between miniature and file)\nNote: This does not delete the actual file from S3/storage",
+                "description": "Get all blog articles, newest publication first; drafts without a publication date come first",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Files"
+                    "Blog - Articles"
                 ],
-                "summary": "Delete miniature image",
+                "summary": "Get all articles",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Miniature File ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Only records with this tag (case-insensitive)",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "draft",
+                            "published",
+                            "archived"
+                        ],
+                        "type": "string",
+                        "description": "Only articles with this status",
+                        "name": "status",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Article"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -58,52 +74,61 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            }
-        },
-        "/miniatures/paints": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all miniature paint entries",
+                "description": "Create a new blog article with optional skills. body is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.\nstatus defaults to draft. A published article without publishedAt is published now. The slug is derived from the title.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Paints"
+                    "Blog - Articles"
+                ],
+                "summary": "Create article",
+                "parameters": [
+                    {
+                        "description": "Article data with optional skillIds",
+                        "name": "article",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.articleRequest"
+                        }
+                    }
                 ],
-                "summary": "Get all miniature paints",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Article"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -113,60 +138,63 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/blog/articles/slug/{slug}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new miniature paint entry",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get a blog article by its current slug. A previous slug redirects (301) to the current one.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Paints"
+                    "Blog - Articles"
                 ],
-                "summary": "Create miniature paint",
+                "summary": "Get article by slug",
                 "parameters": [
                     {
-                        "description": "Paint data",
-                        "name": "paint",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
-                        }
+                        "type": "string",
+                        "description": "Article slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
-                        },
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Article"
+                        }
+                    },
+                    "301": {
+                        "description": "Previous slug; Location is the lookup by the current slug",
                         "headers": {
                             "Location": {
                                 "type": "string",
-                                "description": "URL of the created resource"
+                                "description": "URL of the lookup by the current slug"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -174,46 +202,40 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/miniatures/paints/{id}": {
+        "/blog/articles/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single miniature paint entry by ID",
+                "description": "Get a single blog article by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Paints"
+                    "Blog - Articles"
                 ],
-                "summary": "Get miniature paint by ID",
+                "summary": "Get article by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Paint ID",
+                        "description": "Article ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -223,16 +245,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Article"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -247,19 +266,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
@@ -270,7 +283,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing miniature paint entry",
+                "description": "Update an existing blog article and replace its skills (an empty or missing skillIds removes them). body is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.",
                 "consumes": [
                     "application/json"
                 ],
@@ -278,24 +291,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Paints"
+                    "Blog - Articles"
                 ],
-                "summary": "Update miniature paint",
+                "summary": "Update article",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Paint ID",
+                        "description": "Article ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Paint data",
-                        "name": "paint",
+                        "description": "Article data with optional skillIds",
+                        "name": "article",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
+                            "$ref": "#/definitions/internal_handlers.articleRequest"
                         }
                     }
                 ],
@@ -303,16 +316,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Article"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -327,19 +337,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
@@ -350,15 +354,15 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a miniature paint entry",
+                "description": "Delete a blog article with its skill links, tags, slugs and SEO metadata",
                 "tags": [
-                    "Miniatures - Paints"
+                    "Blog - Articles"
                 ],
-                "summary": "Delete miniature paint",
+                "summary": "Delete article",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Paint ID",
+                        "description": "Article ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -371,10 +375,7 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -389,47 +390,47 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/miniatures/projects": {
+        "/blog/articles/{id}/seo": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all miniature painting projects",
+                "description": "Get the SEO metadata of a blog article: stored overrides, with defaults from its title, summary (or body) and cover image",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Projects"
+                    "Blog - Articles"
+                ],
+                "summary": "Get article SEO metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get all miniature projects",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -441,24 +442,27 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new miniature painting project with optional techniques and paints",
+                "description": "Replace the SEO overrides of a blog article; empty fields use the defaults",
                 "consumes": [
                     "application/json"
                 ],
@@ -466,40 +470,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Projects"
+                    "Blog - Articles"
                 ],
-                "summary": "Create miniature project",
+                "summary": "Set article SEO metadata",
                 "parameters": [
                     {
-                        "description": "Miniature project data with optional techniqueIds and paintIds",
-                        "name": "project",
+                        "type": "integer",
+                        "description": "Article ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SEO overrides",
+                        "name": "seo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.miniatureProjectRequest"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEOMetadata"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
-                        },
-                        "headers": {
-                            "Location": {
-                                "type": "string",
-                                "description": "URL of the created resource"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -511,56 +513,83 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/miniatures/projects/{id}": {
-            "get": {
+        "/blog/articles/{id}/skills": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single miniature project by ID",
+                "description": "Replace all skills of an article with the provided list; an empty list removes them",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Projects"
+                    "Blog - Articles"
                 ],
-                "summary": "Get miniature project by ID",
+                "summary": "Set skills for an article",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Miniature Project ID",
+                        "description": "Article ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "List of skill IDs",
+                        "name": "skills",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "skillIds": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "integer",
+                                        "format": "int64"
+                                    }
+                                }
+                            }
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Article"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -575,30 +604,26 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/blog/articles/{id}/slug": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing miniature project with optional techniques and paints",
+                "description": "Change the slug of a blog article. The old slug keeps redirecting.",
                 "consumes": [
                     "application/json"
                 ],
@@ -606,24 +631,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Projects"
+                    "Blog - Articles"
                 ],
-                "summary": "Update miniature project",
+                "summary": "Set article slug",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Miniature Project ID",
+                        "description": "Article ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Miniature project data with optional techniqueIds and paintIds",
-                        "name": "project",
+                        "description": "New slug",
+                        "name": "slug",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/internal_handlers.miniatureProjectRequest"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SlugRequest"
                         }
                     }
                 ],
@@ -631,16 +656,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slugs"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -655,45 +677,59 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/blog/articles/{id}/slugs": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a miniature project and all associated data (images, techniques, paints)\nNote: This is a cascade delete - one API call deletes everything\nActual image files in S3 are preserved and cleaned up by background job",
+                "description": "Get the current slug of a blog article and the slugs it replaced",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Miniatures - Projects"
+                    "Blog - Articles"
                 ],
-                "summary": "Delete miniature project",
+                "summary": "Get article slugs",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Miniature Project ID",
+                        "description": "Article ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slugs"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -708,74 +744,56 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/miniatures/projects/{id}/images": {
-            "post": {
+        "/blog/articles/{id}/tags": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Link an uploaded image file to a miniature project with optional caption\nDisplay order is automatically assigned based on upload order",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the tags of a blog article by name",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Projects"
+                    "Blog - Articles"
                 ],
-                "summary": "Add image to miniature project",
+                "summary": "Get article tags",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Miniature Project ID",
+                        "description": "Article ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Image data (fileId required, caption optional)",
-                        "name": "image",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "properties": {
-                                "caption": {
-                                    "type": "string"
-                                },
-                                "fileId": {
-                                    "type": "integer",
-                                    "format": "int64"
-                                }
-                            }
-                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureFile"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -790,32 +808,24 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            }
-        },
-        "/miniatures/projects/{id}/paints": {
+            },
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Replace all paints for a project with the provided list",
+                "description": "Replace all tags of a blog article; an empty list removes them",
                 "consumes": [
                     "application/json"
                 ],
@@ -823,26 +833,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Projects"
+                    "Blog - Articles"
                 ],
-                "summary": "Set paints for a miniature project",
+                "summary": "Set article tags",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Miniature Project ID",
+                        "description": "Article ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "List of paint IDs",
-                        "name": "paints",
+                        "description": "List of tag IDs",
+                        "name": "tags",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
                             "properties": {
-                                "paintIds": {
+                                "tagIds": {
                                     "type": "array",
                                     "items": {
                                         "type": "integer",
@@ -857,16 +867,16 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -881,23 +891,32 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/miniatures/projects/{id}/techniques": {
-            "put": {
+        "/cache/purge": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Replace all techniques for a project with the provided list",
+                "description": "Invalidate public API cache keys in Redis. entities purges every key mapped to those\nentity types (per-ID keys as * patterns); keys purges explicit keys or patterns.\nKeys must start with portfolio:, miniatures: or blog: and must not start with a wildcard\nafter it. An empty body purges every mapped key.",
                 "consumes": [
                     "application/json"
                 ],
@@ -905,33 +924,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Projects"
+                    "Cache"
                 ],
-                "summary": "Set techniques for a miniature project",
+                "summary": "Purge public API cache",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Miniature Project ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "List of technique IDs",
-                        "name": "techniques",
+                        "description": "Entities and keys to purge",
+                        "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "techniqueIds": {
-                                    "type": "array",
-                                    "items": {
-                                        "type": "integer",
-                                        "format": "int64"
-                                    }
-                                }
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.CachePurgeRequest"
                         }
                     }
                 ],
@@ -939,16 +941,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.CachePurgeResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -960,41 +959,55 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/miniatures/techniques": {
+        "/dashboard": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all painting techniques from the classifier table",
+                "description": "Get record counts per entity and a content quality report: projects without image,\nprojects without githubUrl and liveUrl, work experience without description,\nskills not used by any project, certifications expiring soon, miniature projects\nwithout images and themes without a cover\nOnly entities the caller has read permission on are included",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Techniques"
+                    "Dashboard"
+                ],
+                "summary": "Get content dashboard",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Report certifications expiring within N days (default 30)",
+                        "name": "expiringWithinDays",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all techniques",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTechnique"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Dashboard"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1009,38 +1022,40 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/miniatures/themes": {
+        "/events/stream": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all miniature painting themes",
+                "description": "Server-Sent Events stream of content change events. Each message has an id and a JSON\nevent (same format as webhook payloads); only entities the caller can read are sent.\nReconnect with the Last-Event-ID header (EventSource does this automatically) to replay\nmissed events. A \"reset\" event means events were missed and data should be reloaded.\nIdle streams receive a comment heartbeat; the stream closes when the token expires.",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "Miniatures - Themes"
+                    "Events"
+                ],
+                "summary": "Stream live changes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID of the last event received",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Get all miniature themes",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "event stream",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
-                            }
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -1052,126 +1067,35 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Create a new miniature painting theme",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Miniatures - Themes"
-                ],
-                "summary": "Create miniature theme",
-                "parameters": [
-                    {
-                        "description": "Miniature theme data",
-                        "name": "theme",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
-                        }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
-                        },
-                        "headers": {
-                            "Location": {
-                                "type": "string",
-                                "description": "URL of the created resource"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/miniatures/themes/{id}": {
+        "/files/orphans": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single miniature theme by ID",
+                "description": "List storage files not referenced by the profile, a portfolio project, a miniature theme\nor a miniature gallery. Files older than the grace period are marked purgeable.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Themes"
-                ],
-                "summary": "Get miniature theme by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Miniature Theme ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Files"
                 ],
+                "summary": "List orphaned files",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.OrphanReport"
                         }
                     },
                     "401": {
@@ -1183,33 +1107,29 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/files/orphans/purge": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing miniature theme",
+                "description": "Delete purgeable orphaned files through files-api. Requires \"confirm\": true.\nfileIds limits the purge to those files; files linked again or still within the\ngrace period are skipped. Files-api is called with the caller's token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1217,24 +1137,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Miniatures - Themes"
+                    "Files"
                 ],
-                "summary": "Update miniature theme",
+                "summary": "Purge orphaned files",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Miniature Theme ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Miniature theme data",
-                        "name": "theme",
+                        "description": "Purge confirmation",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.OrphanPurgeRequest"
                         }
                     }
                 ],
@@ -1242,16 +1155,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.OrphanPurgeResult"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1263,32 +1173,37 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/files/{id}": {
             "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a miniature theme",
+                "description": "Delete a miniature file record (removes link between miniature and file)\nNote: This does not delete the actual file from S3/storage",
                 "tags": [
-                    "Miniatures - Themes"
+                    "Files"
                 ],
-                "summary": "Delete miniature theme",
+                "summary": "Delete miniature image",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Miniature Theme ID",
+                        "description": "Miniature File ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1301,10 +1216,7 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1319,37 +1231,46 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/portfolio/certifications": {
+        "/miniatures/paints": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all certification entries",
+                "description": "Get all miniature paint entries",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Certifications"
+                    "Miniatures - Paints"
                 ],
-                "summary": "Get all certifications",
+                "summary": "Get all miniature paints",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Certification"
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
                             }
                         }
                     },
@@ -1365,10 +1286,7 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
@@ -1379,7 +1297,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new certification entry",
+                "description": "Create a new miniature paint entry",
                 "consumes": [
                     "application/json"
                 ],
@@ -1387,17 +1305,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Certifications"
+                    "Miniatures - Paints"
                 ],
-                "summary": "Create certification",
+                "summary": "Create miniature paint",
                 "parameters": [
                     {
-                        "description": "Certification data",
-                        "name": "certification",
+                        "description": "Paint data",
+                        "name": "paint",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Certification"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
                         }
                     }
                 ],
@@ -1405,7 +1323,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Certification"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
                         },
                         "headers": {
                             "Location": {
@@ -1417,10 +1335,7 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1435,34 +1350,31 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/portfolio/certifications/{id}": {
+        "/miniatures/paints/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single certification entry by ID",
+                "description": "Get a single miniature paint entry by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Certifications"
+                    "Miniatures - Paints"
                 ],
-                "summary": "Get certification by ID",
+                "summary": "Get miniature paint by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Certification ID",
+                        "description": "Paint ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1472,16 +1384,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Certification"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1496,19 +1405,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
@@ -1519,7 +1422,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing certification entry",
+                "description": "Update an existing miniature paint entry",
                 "consumes": [
                     "application/json"
                 ],
@@ -1527,24 +1430,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Certifications"
+                    "Miniatures - Paints"
                 ],
-                "summary": "Update certification",
+                "summary": "Update miniature paint",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Certification ID",
+                        "description": "Paint ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Certification data",
-                        "name": "certification",
+                        "description": "Paint data",
+                        "name": "paint",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Certification"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
                         }
                     }
                 ],
@@ -1552,16 +1455,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Certification"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1576,10 +1476,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
@@ -1590,18 +1493,30 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a certification entry",
+                "description": "Delete a miniature paint entry. Projects that use it block the delete (409) unless\ncascade=true removes it from them or reassignTo moves them to another paint.",
                 "tags": [
-                    "Portfolio - Certifications"
+                    "Miniatures - Paints"
                 ],
-                "summary": "Delete certification",
+                "summary": "Delete miniature paint",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Certification ID",
+                        "description": "Paint ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove the references instead of refusing",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Move the references to this record instead of refusing",
+                        "name": "reassignTo",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1611,10 +1526,7 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1628,38 +1540,159 @@ const docTemplate = `{
                     },
                     "404": {
                         "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/paints/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Repoint the miniature paint links of the source paints to this paint and delete the sources in one transaction.\nSend preview=true to get the same report without changing anything.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Paints"
+                ],
+                "summary": "Merge duplicate paints",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Target paint ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Records to merge into the target",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MergeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
                     }
                 }
             }
         },
-        "/portfolio/experience": {
+        "/miniatures/projects": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all work experience entries",
+                "description": "Get all miniature painting projects. Descriptions follow Accept-Language.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Experience"
+                    "Miniatures - Projects"
+                ],
+                "summary": "Get all miniature projects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only records with this tag (case-insensitive)",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Get all work experience",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
+                            }
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
                             }
                         }
                     },
@@ -1675,10 +1708,7 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
@@ -1689,7 +1719,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new work experience entry",
+                "description": "Create a new miniature painting project with optional techniques and paints. description is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1697,17 +1727,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Experience"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Create work experience",
+                "summary": "Create miniature project",
                 "parameters": [
                     {
-                        "description": "Work experience data",
-                        "name": "experience",
+                        "description": "Miniature project data with optional techniqueIds and paintIds",
+                        "name": "project",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                            "$ref": "#/definitions/internal_handlers.miniatureProjectRequest"
                         }
                     }
                 ],
@@ -1715,7 +1745,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
                         },
                         "headers": {
                             "Location": {
@@ -1727,10 +1757,7 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1744,54 +1771,138 @@ const docTemplate = `{
                     },
                     "500": {
                         "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/projects/slug/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a miniature project by its current slug. A previous slug redirects (301) to the current one. The description follows Accept-Language.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Projects"
+                ],
+                "summary": "Get miniature project by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Miniature Project slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
+                            }
+                        }
+                    },
+                    "301": {
+                        "description": "Previous slug; Location is the lookup by the current slug",
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the lookup by the current slug"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
                     }
                 }
             }
         },
-        "/portfolio/experience/{id}": {
+        "/miniatures/projects/{id}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single work experience entry by ID",
+                "description": "Get a single miniature project by ID. The description follows Accept-Language.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Experience"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Get work experience by ID",
+                "summary": "Get miniature project by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Work Experience ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1806,19 +1917,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
@@ -1829,7 +1934,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing work experience entry",
+                "description": "Update an existing miniature project with optional techniques and paints. description is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1837,24 +1942,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Experience"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Update work experience",
+                "summary": "Update miniature project",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Work Experience ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Work experience data",
-                        "name": "experience",
+                        "description": "Miniature project data with optional techniqueIds and paintIds",
+                        "name": "project",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                            "$ref": "#/definitions/internal_handlers.miniatureProjectRequest"
                         }
                     }
                 ],
@@ -1862,16 +1967,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1886,10 +1988,7 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
@@ -1900,15 +1999,15 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a work experience entry",
+                "description": "Delete a miniature project and all associated data (images, techniques, paints)\nNote: This is a cascade delete - one API call deletes everything\nActual image files in S3 are preserved; purge them later via POST /files/orphans/purge",
                 "tags": [
-                    "Portfolio - Experience"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Delete work experience",
+                "summary": "Delete miniature project",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Work Experience ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1921,10 +2020,7 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1939,35 +2035,65 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
                         }
                     }
                 }
             }
         },
-        "/portfolio/profile": {
-            "get": {
+        "/miniatures/projects/{id}/clone": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get profile information",
+                "description": "Copy a miniature with its theme, techniques and paints (with notes) as a new, unfinished\nminiature titled \"<title> (copy)\". The completion date and time spent are cleared.\nImages are shared with the original only when includeImages=true.\nThe copy starts hidden; PUT /miniatures/projects/{id}/visibility shows it.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Profile"
+                    "Miniatures - Projects"
+                ],
+                "summary": "Clone miniature project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Link the copy to the same images",
+                        "name": "includeImages",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get profile",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Profile"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -1982,30 +2108,26 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/miniatures/projects/{id}/images": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update profile information",
+                "description": "Link an uploaded image file to a miniature project with optional caption\nDisplay order is automatically assigned based on upload order",
                 "consumes": [
                     "application/json"
                 ],
@@ -2013,34 +2135,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Profile"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Update profile",
+                "summary": "Add image to miniature project",
                 "parameters": [
                     {
-                        "description": "Profile data",
-                        "name": "profile",
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Image data (fileId required, caption optional)",
+                        "name": "image",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Profile"
+                            "type": "object",
+                            "properties": {
+                                "caption": {
+                                    "type": "string"
+                                },
+                                "fileId": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Profile"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureFile"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2051,62 +2186,79 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
                     }
                 }
             }
         },
-        "/portfolio/profile/avatar": {
-            "put": {
+        "/miniatures/projects/{id}/images/upload": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update profile avatar by file ID",
+                "description": "Stream an image to files-api and link it to a miniature project in one request.\nForm fields (caption) must come before the file part. If linking fails the\nuploaded file is deleted again.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Profile"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Update profile avatar",
+                "summary": "Upload image to miniature project",
                 "parameters": [
                     {
-                        "description": "File ID",
-                        "name": "fileId",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "properties": {
-                                "fileId": {
-                                    "type": "integer",
-                                    "format": "int64"
-                                }
-                            }
-                        }
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Image caption",
+                        "name": "caption",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureFile"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2117,44 +2269,54 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Remove profile avatar (sets avatar_file_id to NULL)",
-                "tags": [
-                    "Portfolio - Profile"
-                ],
-                "summary": "Delete profile avatar",
-                "responses": {
-                    "204": {
-                        "description": "No Content"
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/portfolio/profile/resume": {
+        "/miniatures/projects/{id}/paints": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update profile resume by file ID",
+                "description": "Replace all paints for a project with the provided list",
                 "consumes": [
                     "application/json"
                 ],
@@ -2162,21 +2324,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Profile"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Update profile resume",
+                "summary": "Set paints for a miniature project",
                 "parameters": [
                     {
-                        "description": "File ID",
-                        "name": "fileId",
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "List of paint IDs",
+                        "name": "paints",
                         "in": "body",
                         "required": true,
                         "schema": {
                             "type": "object",
                             "properties": {
-                                "fileId": {
-                                    "type": "integer",
-                                    "format": "int64"
+                                "paintIds": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "integer",
+                                        "format": "int64"
+                                    }
                                 }
                             }
                         }
@@ -2186,19 +2358,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2209,59 +2375,51 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Remove profile resume (sets resume_file_id to NULL)",
-                "tags": [
-                    "Portfolio - Profile"
-                ],
-                "summary": "Delete profile resume",
-                "responses": {
-                    "204": {
-                        "description": "No Content"
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/portfolio/projects": {
+        "/miniatures/projects/{id}/seo": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all portfolio projects",
+                "description": "Get the SEO metadata of a miniature project: stored overrides, with defaults from its title, description and first gallery image",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Projects"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Get all portfolio projects",
-                "responses": {
+                "summary": "Get miniature project SEO metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2273,24 +2431,27 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new portfolio project",
+                "description": "Replace the SEO overrides of a miniature project; empty fields use the defaults",
                 "consumes": [
                     "application/json"
                 ],
@@ -2298,40 +2459,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Projects"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Create portfolio project",
+                "summary": "Set miniature project SEO metadata",
                 "parameters": [
                     {
-                        "description": "Portfolio project data",
-                        "name": "project",
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SEO overrides",
+                        "name": "seo",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEOMetadata"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
-                        },
-                        "headers": {
-                            "Location": {
-                                "type": "string",
-                                "description": "URL of the created resource"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2343,56 +2502,74 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/portfolio/projects/{id}": {
-            "get": {
+        "/miniatures/projects/{id}/slug": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single portfolio project by ID",
+                "description": "Change the slug of a miniature project. The old slug keeps redirecting.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Projects"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Get portfolio project by ID",
+                "summary": "Set miniature project slug",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Portfolio Project ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "New slug",
+                        "name": "slug",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SlugRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slugs"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2407,72 +2584,59 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/miniatures/projects/{id}/slugs": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing portfolio project",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the current slug of a miniature project and the slugs it replaced",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Projects"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Update portfolio project",
+                "summary": "Get miniature project slugs",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Portfolio Project ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Portfolio project data",
-                        "name": "project",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slugs"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2487,45 +2651,56 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/miniatures/projects/{id}/tags": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a portfolio project and all associated technology links\nNote: This is a cascade delete - one API call deletes the project and all technology associations\nThe project image file in S3 is preserved and cleaned up by background job",
+                "description": "Get the tags of a miniature project by name",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Portfolio - Projects"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Delete portfolio project",
+                "summary": "Get miniature project tags",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Portfolio Project ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2540,40 +2715,77 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            }
-        },
-        "/portfolio/skill-types": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all skill type categories",
+                "description": "Replace all tags of a miniature project; an empty list removes them",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Projects"
+                ],
+                "summary": "Set miniature project tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "List of tag IDs",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "tagIds": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "integer",
+                                        "format": "int64"
+                                    }
+                                }
+                            }
+                        }
+                    }
                 ],
-                "summary": "Get all skill types",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
                             }
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -2583,24 +2795,35 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/miniatures/projects/{id}/techniques": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new skill type category",
+                "description": "Replace all techniques for a project with the provided list",
                 "consumes": [
                     "application/json"
                 ],
@@ -2608,40 +2831,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Create skill type",
+                "summary": "Set techniques for a miniature project",
                 "parameters": [
                     {
-                        "description": "Skill type data",
-                        "name": "skillType",
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "List of technique IDs",
+                        "name": "techniques",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
-                        }
-                    }
-                ],
+                            "type": "object",
+                            "properties": {
+                                "techniqueIds": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "integer",
+                                        "format": "int64"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
-                        },
-                        "headers": {
-                            "Location": {
-                                "type": "string",
-                                "description": "URL of the created resource"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2653,37 +2883,34 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/portfolio/skill-types/{id}": {
+        "/miniatures/projects/{id}/translations": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single skill type by ID",
+                "description": "Get the translated description of a miniature project by locale",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Get skill type by ID",
+                "summary": "Get miniature project translations",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skill Type ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2693,16 +2920,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Translations"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2717,30 +2941,26 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/miniatures/projects/{id}/translations/{locale}": {
             "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing skill type",
+                "description": "Replace the translations of a miniature project in one locale. Translatable fields: description. description is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2748,24 +2968,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Update skill type",
+                "summary": "Set miniature project translations",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skill Type ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Skill type data",
-                        "name": "skillType",
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Translation locale",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Translated fields",
+                        "name": "translations",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.TranslationRequest"
                         }
                     }
                 ],
@@ -2773,16 +3001,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Translations"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2797,45 +3022,53 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/miniatures/projects/{id}/visibility": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a skill type",
+                "description": "Get whether a miniature project is shown on the public site. Clones start hidden.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Projects"
                 ],
-                "summary": "Delete skill type",
+                "summary": "Get miniature project visibility",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skill Type ID",
+                        "description": "Miniature Project ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Visibility"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2850,38 +3083,63 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            }
-        },
-        "/portfolio/skills": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all skills",
+                "description": "Show or hide a miniature project on the public site",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Projects"
+                ],
+                "summary": "Set miniature project visibility",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Visibility",
+                        "name": "visibility",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Visibility"
+                        }
+                    }
                 ],
-                "summary": "Get all skills",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Visibility"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2893,65 +3151,67 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/miniatures/stats": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new skill",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get painting output totals and breakdowns: projects completed per month and year,\ntotal and average time spent, most used paints and techniques, and distribution\nby theme, scale, manufacturer and difficulty\nOptional from/to dates restrict all figures to projects completed in that range",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Stats"
                 ],
-                "summary": "Create skill",
+                "summary": "Get miniature hobby statistics",
                 "parameters": [
                     {
-                        "description": "Skill data",
-                        "name": "skill",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
-                        }
+                        "type": "string",
+                        "description": "Completed on or after (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Completed on or before (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max entries in top paints/techniques (default 10, max 50)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
-                        },
-                        "headers": {
-                            "Location": {
-                                "type": "string",
-                                "description": "URL of the created resource"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureStats"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -2966,52 +3226,34 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
             }
         },
-        "/portfolio/skills/{id}": {
+        "/miniatures/techniques": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a single skill by ID",
+                "description": "Get all painting techniques from the classifier table",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Skills"
-                ],
-                "summary": "Get skill by ID",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Skill ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Miniatures - Techniques"
                 ],
+                "summary": "Get all techniques",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTechnique"
                             }
                         }
                     },
@@ -3024,33 +3266,72 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/miniatures/themes": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing skill",
+                "description": "Get all miniature painting themes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Get all miniature themes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only records with this tag (case-insensitive)",
+                        "name": "tag",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new miniature painting theme",
                 "consumes": [
                     "application/json"
                 ],
@@ -3058,36 +3339,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Themes"
                 ],
-                "summary": "Update skill",
+                "summary": "Create miniature theme",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Skill ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Skill data",
-                        "name": "skill",
+                        "description": "Miniature theme data",
+                        "name": "theme",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -3095,6 +3381,61 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/slug/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a miniature theme by its current slug. A previous slug redirects (301) to the current one.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Get miniature theme by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Miniature Theme slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
+                        }
+                    },
+                    "301": {
+                        "description": "Previous slug; Location is the lookup by the current slug",
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the lookup by the current slug"
+                            }
+                        }
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -3106,46 +3447,125 @@ const docTemplate = `{
                     },
                     "404": {
                         "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single miniature theme by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Get miniature theme by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete a skill",
+                "description": "Update an existing miniature theme",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Portfolio - Skills"
+                    "Miniatures - Themes"
                 ],
-                "summary": "Delete skill",
+                "summary": "Update miniature theme",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Skill ID",
+                        "description": "Miniature Theme ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Miniature theme data",
+                        "name": "theme",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     },
                     "401": {
@@ -3160,205 +3580,6723 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
                         }
                     }
                 }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a miniature theme. Miniatures in the theme block the delete (409) unless\ncascade=true leaves them without a theme or reassignTo moves them to another theme.",
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Delete miniature theme",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove the references instead of refusing",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Move the references to this record instead of refusing",
+                        "name": "reassignTo",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/{id}/seo": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the SEO metadata of a miniature theme: stored overrides, with defaults from its name, description and cover image",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Get miniature theme SEO metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the SEO overrides of a miniature theme; empty fields use the defaults",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Set miniature theme SEO metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SEO overrides",
+                        "name": "seo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEOMetadata"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/{id}/slug": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the slug of a miniature theme. The old slug keeps redirecting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Set miniature theme slug",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New slug",
+                        "name": "slug",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SlugRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slugs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/{id}/slugs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the current slug of a miniature theme and the slugs it replaced",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Get miniature theme slugs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slugs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/miniatures/themes/{id}/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the tags of a miniature theme by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Get miniature theme tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace all tags of a miniature theme; an empty list removes them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Miniatures - Themes"
+                ],
+                "summary": "Set miniature theme tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Miniature Theme ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "List of tag IDs",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "tagIds": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "integer",
+                                        "format": "int64"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/certifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all certification entries with computed expiry status (active, expiring or expired)\nexpiring=90d returns certifications expiring within 90 days that have not expired yet\nexpired=true returns only expired certifications, expired=false only non-expired ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Certifications"
+                ],
+                "summary": "Get all certifications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only certifications expiring within the given days (e.g. 90d)",
+                        "name": "expiring",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Filter by expired state",
+                        "name": "expired",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.CertificationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new certification entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Certifications"
+                ],
+                "summary": "Create certification",
+                "parameters": [
+                    {
+                        "description": "Certification data",
+                        "name": "certification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Certification"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.CertificationResponse"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/certifications/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single certification entry by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Certifications"
+                ],
+                "summary": "Get certification by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.CertificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing certification entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Certifications"
+                ],
+                "summary": "Update certification",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Certification data",
+                        "name": "certification",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Certification"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.CertificationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a certification entry",
+                "tags": [
+                    "Portfolio - Certifications"
+                ],
+                "summary": "Delete certification",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Certification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/education": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all education entries ordered by display order, then most recent first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Education"
+                ],
+                "summary": "Get all education",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Education"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new education entry. Leave endDate empty for studies in progress.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Education"
+                ],
+                "summary": "Create education",
+                "parameters": [
+                    {
+                        "description": "Education data",
+                        "name": "education",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Education"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Education"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/education/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single education entry by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Education"
+                ],
+                "summary": "Get education by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Education ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Education"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing education entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Education"
+                ],
+                "summary": "Update education",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Education ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Education data",
+                        "name": "education",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Education"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Education"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an education entry",
+                "tags": [
+                    "Portfolio - Education"
+                ],
+                "summary": "Delete education",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Education ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/experience": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all work experience entries. Descriptions follow Accept-Language.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Experience"
+                ],
+                "summary": "Get all work experience",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                            }
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new work experience entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Experience"
+                ],
+                "summary": "Create work experience",
+                "parameters": [
+                    {
+                        "description": "Work experience data",
+                        "name": "experience",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/experience/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single work experience entry by ID. The description follows Accept-Language.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Experience"
+                ],
+                "summary": "Get work experience by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Work Experience ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing work experience entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Experience"
+                ],
+                "summary": "Update work experience",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Work Experience ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Work experience data",
+                        "name": "experience",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WorkExperience"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a work experience entry",
+                "tags": [
+                    "Portfolio - Experience"
+                ],
+                "summary": "Delete work experience",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Work Experience ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/experience/{id}/translations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the translated description of a work experience entry by locale",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Experience"
+                ],
+                "summary": "Get work experience translations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Work Experience ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Translations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/experience/{id}/translations/{locale}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the translations of a work experience entry in one locale. Translatable fields: description.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Experience"
+                ],
+                "summary": "Set work experience translations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Work Experience ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Translation locale",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Translated fields",
+                        "name": "translations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.TranslationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Translations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/profile": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get profile information. Title and tagline follow Accept-Language.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Get profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Profile"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update profile information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Update profile",
+                "parameters": [
+                    {
+                        "description": "Profile data",
+                        "name": "profile",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Profile"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Profile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/profile/avatar": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update profile avatar by file ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Update profile avatar",
+                "parameters": [
+                    {
+                        "description": "File ID",
+                        "name": "fileId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "fileId": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove profile avatar (sets avatar_file_id to NULL)",
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Delete profile avatar",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/profile/avatar/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stream an image to files-api and set it as the profile avatar in one request.\nIf linking fails the uploaded file is deleted again.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Upload profile avatar",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Avatar image",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.StorageFile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/profile/resume": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update profile resume by file ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Update profile resume",
+                "parameters": [
+                    {
+                        "description": "File ID",
+                        "name": "fileId",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "fileId": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove profile resume (sets resume_file_id to NULL)",
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Delete profile resume",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/profile/resume/upload": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stream a PDF to files-api and set it as the profile resume in one request.\nIf linking fails the uploaded file is deleted again.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Upload profile resume",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Resume PDF",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.StorageFile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "502": {
+                        "description": "Bad Gateway",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/profile/seo": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the SEO metadata of the profile page: stored overrides, with defaults from the name and title, bio and avatar",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Get profile SEO metadata",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the SEO overrides of the profile page; empty fields use the defaults",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Set profile SEO metadata",
+                "parameters": [
+                    {
+                        "description": "SEO overrides",
+                        "name": "seo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEOMetadata"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/profile/translations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the translated title and tagline of the profile by locale",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Get profile translations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Translations"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/profile/translations/{locale}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the translations of the profile in one locale. Translatable fields: title, tagline.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Profile"
+                ],
+                "summary": "Set profile translations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Translation locale",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Translated fields",
+                        "name": "translations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.TranslationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Translations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all portfolio projects. Translatable fields follow Accept-Language.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Get all portfolio projects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only records with this tag (case-insensitive)",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                            }
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new portfolio project. longDescription is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Create portfolio project",
+                "parameters": [
+                    {
+                        "description": "Portfolio project data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/slug/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a portfolio project by its current slug. A previous slug redirects (301) to the current one. Translatable fields follow Accept-Language.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Get portfolio project by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
+                            }
+                        }
+                    },
+                    "301": {
+                        "description": "Previous slug; Location is the lookup by the current slug",
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the lookup by the current slug"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single portfolio project by ID. Translatable fields follow Accept-Language.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Get portfolio project by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Portfolio Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Content language; translation locales replace translated fields",
+                        "name": "Accept-Language",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                        },
+                        "headers": {
+                            "Content-Language": {
+                                "type": "string",
+                                "description": "Locale of the translatable fields"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing portfolio project. longDescription is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Update portfolio project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Portfolio Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Portfolio project data",
+                        "name": "project",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a portfolio project and all associated technology links\nNote: This is a cascade delete - one API call deletes the project and all technology associations\nThe project image file in S3 is preserved; purge it later via POST /files/orphans/purge",
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Delete portfolio project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Portfolio Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}/clone": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copy a portfolio project with its technologies as a new project titled \"<title> (copy)\".\nThe copy is not featured and keeps the image only when includeImages=true.\nThe copy starts hidden; PUT /portfolio/projects/{id}/visibility shows it.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Clone portfolio project",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Keep the project image",
+                        "name": "includeImages",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}/seo": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the SEO metadata of a portfolio project: stored overrides, with defaults from its title, description and image",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Get portfolio project SEO metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the SEO overrides of a portfolio project; empty fields use the defaults",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Set portfolio project SEO metadata",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "SEO overrides",
+                        "name": "seo",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEOMetadata"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}/slug": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the slug of a portfolio project. The old slug keeps redirecting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Set portfolio project slug",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New slug",
+                        "name": "slug",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SlugRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slugs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}/slugs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the current slug of a portfolio project and the slugs it replaced",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Get portfolio project slugs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slugs"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the tags of a portfolio project by name",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Get portfolio project tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace all tags of a portfolio project; an empty list removes them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Set portfolio project tags",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "List of tag IDs",
+                        "name": "tags",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "tagIds": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "integer",
+                                        "format": "int64"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}/translations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the translated fields of a portfolio project by locale",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Get portfolio project translations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Portfolio Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Translations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}/translations/{locale}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the translations of a portfolio project in one locale. Translatable fields: description, longDescription, challenges and learnings (lists). longDescription is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Set portfolio project translations",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Portfolio Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "lv",
+                        "description": "Translation locale",
+                        "name": "locale",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Translated fields",
+                        "name": "translations",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.TranslationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Translations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/projects/{id}/visibility": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get whether a portfolio project is shown on the public site. Clones start hidden.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Get portfolio project visibility",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Visibility"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show or hide a portfolio project on the public site",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Projects"
+                ],
+                "summary": "Set portfolio project visibility",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Project ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Visibility",
+                        "name": "visibility",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Visibility"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Visibility"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/skill-types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all skill type categories",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Get all skill types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new skill type category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Create skill type",
+                "parameters": [
+                    {
+                        "description": "Skill type data",
+                        "name": "skillType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/skill-types/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single skill type by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Get skill type by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing skill type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Update skill type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skill type data",
+                        "name": "skillType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SkillType"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a skill type. Skills of this type block the delete (409) unless cascade=true\ndeletes them too or reassignTo moves them to another skill type.",
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Delete skill type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove the references instead of refusing",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Move the references to this record instead of refusing",
+                        "name": "reassignTo",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/skill-types/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move the skills of the source skill types to this type and delete the sources in one transaction.\nSend preview=true to get the same report without changing anything.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Merge duplicate skill types",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Target skill type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Records to merge into the target",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MergeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/skills": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all skills",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Get all skills",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new skill",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Create skill",
+                "parameters": [
+                    {
+                        "description": "Skill data",
+                        "name": "skill",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/skills/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single skill by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Get skill by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing skill",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Update skill",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Skill data",
+                        "name": "skill",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a skill. Projects that still list it block the delete (409) unless cascade=true\nremoves it from those projects or reassignTo moves them to another skill.",
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Delete skill",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Remove the references instead of refusing",
+                        "name": "cascade",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Move the references to this record instead of refusing",
+                        "name": "reassignTo",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/skills/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Repoint the project technologies of the source skills to this skill and delete the sources in one transaction.\nSend preview=true to get the same report without changing anything.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Skills"
+                ],
+                "summary": "Merge duplicate skills",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Target skill ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Records to merge into the target",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MergeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MergeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/testimonials": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all testimonials ordered by display order, then newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Testimonials"
+                ],
+                "summary": "Get all testimonials",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only approved (true) or unapproved (false) testimonials",
+                        "name": "approved",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only testimonials linked to this work experience",
+                        "name": "workExperienceId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only testimonials linked to this portfolio project",
+                        "name": "portfolioProjectId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Testimonial"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new testimonial. Link it to at most one of a work experience entry or a portfolio project.\nNew testimonials are unapproved unless approved is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Testimonials"
+                ],
+                "summary": "Create testimonial",
+                "parameters": [
+                    {
+                        "description": "Testimonial data",
+                        "name": "testimonial",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Testimonial"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Testimonial"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/testimonials/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single testimonial by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Testimonials"
+                ],
+                "summary": "Get testimonial by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Testimonial ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Testimonial"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing testimonial",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Portfolio - Testimonials"
+                ],
+                "summary": "Update testimonial",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Testimonial ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Testimonial data",
+                        "name": "testimonial",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Testimonial"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Testimonial"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a testimonial",
+                "tags": [
+                    "Portfolio - Testimonials"
+                ],
+                "summary": "Delete testimonial",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Testimonial ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/render/markdown": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Render markdown to the sanitized HTML the public site shows, for live previews while editing. Scripts, event handler attributes, unsafe URLs and other HTML outside the allowlist are removed and listed. Nothing is stored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Markdown"
+                ],
+                "summary": "Render markdown",
+                "parameters": [
+                    {
+                        "description": "Markdown to render",
+                        "name": "markdown",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MarkdownRenderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MarkdownPreview"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search over portfolio projects, work experience, certifications, skills,\nminiature projects and themes. q accepts web search syntax: \"quoted phrases\", or, -excluded.\nHits are ranked best first and carry a snippet with the matches wrapped in <mark>.\nOnly entities the caller has read permission on are searched.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "summary": "Search admin content",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search text",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of hits (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SearchResults"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/seo/check": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the records whose resolved SEO metadata has issues: missing or too long titles and descriptions, missing preview images. Only entities the caller can read are checked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SEO"
+                ],
+                "summary": "Check SEO metadata",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEOReport"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all tags by name with the number of records using each, in total and per entity type",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get all tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new tag. Names are unique regardless of case.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Create tag",
+                "parameters": [
+                    {
+                        "description": "Tag data",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single tag with its usage counts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Get tag by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rename or describe a tag. Tagged records keep the tag.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Update tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tag data",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Tag"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a tag and remove it from every record",
+                "tags": [
+                    "Tags"
+                ],
+                "summary": "Delete tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/translations/missing": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List, per record and translation locale, the fields that have text but no translation. Only entities the caller can read are included.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Translations"
+                ],
+                "summary": "Get missing translations",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Only this translation locale",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MissingTranslationsReport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all registered outbound webhooks (secrets are never returned)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get all webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Webhook"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Register an outbound webhook. events lists event names (portfolio.project.updated),\nprefix wildcards (miniatures.*) or * for all events. The secret (min 16 characters)\nsigns every payload with HMAC-SHA256 and is never returned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Webhook"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "URL of the created resource"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a single webhook by ID (the secret is never returned)",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing webhook. Omit secret to keep the current one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Webhook"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a webhook and its delivery log",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/internal_handlers.dependentsProblem"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the most recent deliveries of a webhook with status, attempts and last error",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of deliveries (1-200, default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/test": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Synchronously deliver a webhook.test event to the webhook (single attempt, no retries)\nand return the recorded delivery. A failed delivery is still returned with 200.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Send test event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Article": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "coverFile": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.StorageFile"
+                        }
+                    ]
+                },
+                "coverFileId": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "publishedAt": {
+                    "type": "string"
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.ArticleSkill"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "replacing-cron-jobs-with-an-outbox"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "published",
+                        "archived"
+                    ],
+                    "example": "published"
+                },
+                "summary": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "How change events stopped getting lost between the database and the broker."
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Replacing cron jobs with an outbox"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.ArticleSkill": {
+            "type": "object",
+            "properties": {
+                "articleId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "skill": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Skill"
+                        }
+                    ]
+                },
+                "skillId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.CachePurgeRequest": {
+            "type": "object",
+            "required": [
+                "entities",
+                "keys"
+            ],
+            "properties": {
+                "entities": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.CachePurgeResponse": {
+            "type": "object",
+            "properties": {
+                "purged": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Certification": {
+            "type": "object",
+            "required": [
+                "issueDate",
+                "issuer",
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "credentialId": {
+                    "type": "string"
+                },
+                "credentialUrl": {
+                    "type": "string"
+                },
+                "expiryDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "issueDate": {
+                    "type": "string"
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.CertificationResponse": {
+            "type": "object",
+            "required": [
+                "issueDate",
+                "issuer",
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "credentialId": {
+                    "type": "string"
+                },
+                "credentialUrl": {
+                    "type": "string"
+                },
+                "daysUntilExpiry": {
+                    "type": "integer"
+                },
+                "expiryDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "issueDate": {
+                    "type": "string"
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.ContentFinding": {
+            "type": "object",
+            "properties": {
+                "check": {
+                    "type": "string"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "entity": {
+                    "type": "string"
+                },
+                "entityId": {
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Dashboard": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.EntityCount"
+                    }
+                },
+                "findingCounts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "findings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.ContentFinding"
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Dependent": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "type": "string",
+                    "example": "skill"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Go"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Education": {
+            "type": "object",
+            "required": [
+                "degree",
+                "institution",
+                "startDate"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "degree": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Bachelor of Science"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2019-06-15"
+                },
+                "fieldOfStudy": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Computer Science"
+                },
+                "grade": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "With distinction"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "institution": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "University of Latvia"
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2015-09-01"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.EntityCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "entity": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MarkdownPreview": {
+            "type": "object",
+            "properties": {
+                "html": {
+                    "type": "string",
+                    "example": "\u003cp\u003eBuilt on \u003cstrong\u003eKafka\u003c/strong\u003e, see \u003ca href=\"https://github.com/example/orders\" rel=\"nofollow noopener\"\u003ethe repo\u003c/a\u003e\u003c/p\u003e"
+                },
+                "removed": {
+                    "description": "Removed lists what the sanitizer took out, such as \u003cscript\u003e elements\nand unsafe link URLs; saving markdown with any of them is rejected",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "\u003cscript\u003e element",
+                        "onclick attribute on \u003cb\u003e"
+                    ]
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MarkdownRenderRequest": {
+            "type": "object",
+            "properties": {
+                "markdown": {
+                    "type": "string",
+                    "maxLength": 20000,
+                    "example": "Built on **Kafka**, see [the repo](https://github.com/example/orders)"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MergeReference": {
+            "type": "object",
+            "properties": {
+                "deduplicated": {
+                    "description": "Deduplicated rows were dropped because the target was already linked",
+                    "type": "integer",
+                    "example": 1
+                },
+                "repointed": {
+                    "description": "Repointed rows now reference the target",
+                    "type": "integer",
+                    "example": 4
+                },
+                "table": {
+                    "type": "string",
+                    "example": "portfolio.project_technologies"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MergeRequest": {
+            "type": "object",
+            "required": [
+                "sourceIds"
+            ],
+            "properties": {
+                "preview": {
+                    "description": "Preview reports what the merge would change without applying it",
+                    "type": "boolean",
+                    "example": true
+                },
+                "sourceIds": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        7
+                    ]
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MergeResult": {
+            "type": "object",
+            "properties": {
+                "dependents": {
+                    "description": "Dependents are the records whose references move to the target",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Dependent"
+                    }
+                },
+                "preview": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "references": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MergeReference"
+                    }
+                },
+                "sourceIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        7
+                    ]
+                },
+                "targetId": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureFile": {
+            "type": "object",
+            "properties": {
+                "caption": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "file": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
+                },
+                "fileId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "miniatureProjectId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint": {
+            "type": "object",
+            "required": [
+                "manufacturer",
+                "name"
+            ],
+            "properties": {
+                "colorHex": {
+                    "description": "ColorHex is the hexadecimal color code in #RRGGBB or #RGB format (e.g., #FF5733, #F00)",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paintType": {
+                    "description": "PaintType categorizes the paint (Base, Layer, Shade, Wash, Contrast, Dry, Technical, Metallic, Air, Primer, Edge, Glaze, Ink)\nDatabase enforces these values via CHECK constraint",
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "completedDate": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficulty": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "images": {
+                    "description": "Computed field (populated by repository layer - requires URL building)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Image"
+                    }
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint"
+                    }
+                },
+                "scale": {
+                    "type": "string"
+                },
+                "techniques": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique"
+                    }
+                },
+                "theme": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTheme"
+                        }
+                    ]
+                },
+                "themeId": {
+                    "type": "integer"
+                },
+                "timeSpent": {
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureStats": {
+            "type": "object",
+            "properties": {
+                "averageTimeSpent": {
+                    "type": "number"
+                },
+                "byDifficulty": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.ValueCount"
+                    }
+                },
+                "byManufacturer": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.ValueCount"
+                    }
+                },
+                "byScale": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.ValueCount"
+                    }
+                },
+                "byTheme": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.NamedCount"
+                    }
+                },
+                "completedByMonth": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PeriodCount"
+                    }
+                },
+                "completedByYear": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.PeriodCount"
+                    }
+                },
+                "completedProjects": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "topPaints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.NamedCount"
+                    }
+                },
+                "topTechniques": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.NamedCount"
+                    }
+                },
+                "totalProjects": {
+                    "type": "integer"
+                },
+                "totalTimeSpent": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTechnique": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "difficultyLevel": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "coverImageFile": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
+                        }
+                    ]
+                },
+                "coverImageId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "miniatures": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProject"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MissingTranslation": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "type": "string",
+                    "example": "portfolio_project"
+                },
+                "entityId": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "description",
+                        "challenges"
+                    ]
+                },
+                "locale": {
+                    "type": "string",
+                    "example": "lv"
+                },
+                "path": {
+                    "description": "Path is the admin API path of the record",
+                    "type": "string",
+                    "example": "/portfolio/projects/12"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Order pipeline"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.MissingTranslationsReport": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.MissingTranslation"
+                    }
+                },
+                "locales": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "lv"
+                    ]
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.NamedCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.OrphanPurgeFailure": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "fileId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.OrphanPurgeRequest": {
+            "type": "object",
+            "properties": {
+                "confirm": {
+                    "type": "boolean"
+                },
+                "fileIds": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.OrphanPurgeResult": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "failed": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.OrphanPurgeFailure"
+                    }
+                },
+                "skipped": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.OrphanReport": {
+            "type": "object",
+            "properties": {
+                "files": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.OrphanedFile"
+                    }
+                },
+                "gracePeriod": {
+                    "type": "string"
+                },
+                "purgeable": {
+                    "type": "integer"
+                },
+                "purgeableBytes": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.OrphanedFile": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "fileSize": {
+                    "type": "integer"
+                },
+                "fileType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mimeType": {
+                    "type": "string"
+                },
+                "purgeable": {
+                    "type": "boolean"
+                },
+                "url": {
+                    "description": "Computed field",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.PeriodCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "challenges": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "displayOrder": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "featured": {
+                    "type": "boolean"
+                },
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "githubUrl": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "imageFile": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
+                        }
+                    ]
+                },
+                "imageFileId": {
+                    "type": "integer"
+                },
+                "isOngoing": {
+                    "type": "boolean"
+                },
+                "learnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "liveUrl": {
+                    "type": "string"
+                },
+                "longDescription": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "teamSize": {
+                    "type": "integer"
+                },
+                "technologies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Skill"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
             }
-        }
-    },
-    "definitions": {
-        "github_com_GunarsK-portfolio_admin-api_internal_models.Certification": {
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Profile": {
             "type": "object",
             "required": [
-                "issueDate",
-                "issuer",
                 "name"
             ],
             "properties": {
-                "createdAt": {
-                    "type": "string"
+                "avatarFile": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
                 },
-                "credentialId": {
+                "avatarFileId": {
+                    "type": "integer"
+                },
+                "createdAt": {
                     "type": "string"
                 },
-                "credentialUrl": {
+                "email": {
                     "type": "string"
                 },
-                "expiryDate": {
+                "github": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "issueDate": {
+                "linkedin": {
                     "type": "string"
                 },
-                "issuer": {
+                "location": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "updatedAt": {
-                    "type": "string"
-                }
-            }
-        },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureFile": {
-            "type": "object",
-            "properties": {
-                "caption": {
-                    "type": "string"
-                },
-                "createdAt": {
+                "phone": {
                     "type": "string"
                 },
-                "displayOrder": {
-                    "type": "integer"
-                },
-                "file": {
+                "resumeFile": {
                     "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
                 },
-                "fileId": {
+                "resumeFileId": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "integer"
+                "tagline": {
+                    "type": "string"
                 },
-                "miniatureProjectId": {
-                    "type": "integer"
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniaturePaint": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.SEO": {
             "type": "object",
-            "required": [
-                "manufacturer",
-                "name"
-            ],
             "properties": {
-                "colorHex": {
-                    "description": "ColorHex is the hexadecimal color code in #RRGGBB or #RGB format (e.g., #FF5733, #F00)",
+                "canonicalUrl": {
                     "type": "string"
                 },
-                "createdAt": {
-                    "type": "string"
+                "entity": {
+                    "type": "string",
+                    "example": "portfolio_project"
                 },
-                "id": {
-                    "type": "integer"
+                "entityId": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "manufacturer": {
-                    "type": "string"
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEOIssue"
+                    }
                 },
-                "name": {
+                "metaDescription": {
                     "type": "string"
                 },
-                "paintType": {
-                    "description": "PaintType categorizes the paint (Base, Layer, Shade, Wash, Contrast, Dry, Technical, Metallic, Air, Primer, Edge, Glaze, Ink)\nDatabase enforces these values via CHECK constraint",
-                    "type": "string"
+                "metaTitle": {
+                    "type": "string",
+                    "example": "Order pipeline"
                 },
-                "updatedAt": {
-                    "type": "string"
+                "ogImage": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.StorageFile"
+                },
+                "overrides": {
+                    "description": "Overrides are the stored fields; the others are derived",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEOMetadata"
+                        }
+                    ]
+                },
+                "path": {
+                    "description": "Path is the admin API path of the record (set in reports)",
+                    "type": "string",
+                    "example": "/portfolio/projects/12"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureProject": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.SEOIssue": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
-                "completedDate": {
-                    "type": "string"
+                "field": {
+                    "type": "string",
+                    "example": "metaTitle"
                 },
-                "createdAt": {
-                    "type": "string"
+                "message": {
+                    "type": "string",
+                    "example": "is 72 characters, search results show about 60"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.SEOMetadata": {
+            "type": "object",
+            "properties": {
+                "canonicalUrl": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "https://example.com/projects/order-pipeline"
                 },
-                "description": {
-                    "type": "string"
+                "metaDescription": {
+                    "type": "string",
+                    "maxLength": 500
                 },
-                "difficulty": {
-                    "type": "string"
+                "metaTitle": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Order pipeline on Kafka"
                 },
-                "displayOrder": {
+                "ogImageFileId": {
                     "type": "integer"
                 },
-                "id": {
-                    "type": "integer"
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.SEOReport": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "integer",
+                    "example": 42
                 },
-                "images": {
-                    "description": "Computed field (populated by repository layer - requires URL building)",
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Image"
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SEO"
                     }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.SearchHit": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "type": "string",
+                    "example": "portfolio_project"
                 },
-                "manufacturer": {
-                    "type": "string"
+                "entityId": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "name": {
-                    "type": "string"
+                "path": {
+                    "type": "string",
+                    "example": "/portfolio/projects/12"
                 },
-                "paints": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectPaint"
-                    }
+                "rank": {
+                    "type": "number",
+                    "example": 0.61
                 },
-                "scale": {
-                    "type": "string"
+                "snippet": {
+                    "description": "Snippet is HTML-escaped text around the matches, each wrapped in \u003cmark\u003e",
+                    "type": "string",
+                    "example": "Streams orders through \u003cmark\u003eKafka\u003c/mark\u003e topics"
                 },
-                "techniques": {
+                "title": {
+                    "type": "string",
+                    "example": "Order pipeline"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.SearchResults": {
+            "type": "object",
+            "properties": {
+                "hits": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProjectTechnique"
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.SearchHit"
                     }
                 },
-                "theme": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureTheme"
-                        }
-                    ]
-                },
-                "themeId": {
-                    "type": "integer"
-                },
-                "timeSpent": {
-                    "type": "number"
-                },
-                "updatedAt": {
-                    "type": "string"
+                "query": {
+                    "type": "string",
+                    "example": "kafka"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTechnique": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Skill": {
             "type": "object",
             "required": [
-                "name"
+                "skill",
+                "skillTypeId"
             ],
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
-                },
-                "difficultyLevel": {
-                    "type": "string"
-                },
                 "displayOrder": {
                     "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
+                "isVisible": {
+                    "type": "boolean"
+                },
+                "skill": {
+                    "type": "string"
+                },
+                "skillType": {
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.SkillType"
+                },
+                "skillTypeId": {
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "Computed field",
                     "type": "string"
                 },
                 "updatedAt": {
@@ -3366,23 +10304,12 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.MiniatureTheme": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.SkillType": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
-                "coverImageFile": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
-                        }
-                    ]
-                },
-                "coverImageId": {
-                    "type": "integer"
-                },
                 "createdAt": {
                     "type": "string"
                 },
@@ -3395,12 +10322,6 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
-                "miniatures": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.MiniatureProject"
-                    }
-                },
                 "name": {
                     "type": "string"
                 },
@@ -3409,210 +10330,318 @@ const docTemplate = `{
                 }
             }
         },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.PortfolioProject": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Slug": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "order-pipeline"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.SlugRequest": {
             "type": "object",
             "required": [
-                "title"
+                "slug"
             ],
             "properties": {
-                "category": {
-                    "type": "string"
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "order-pipeline"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Slugs": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "type": "string",
+                    "example": "order-pipeline"
                 },
-                "challenges": {
+                "previous": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Slug"
                     }
-                },
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.StorageFile": {
+            "type": "object",
+            "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
-                    "type": "string"
-                },
-                "displayOrder": {
-                    "type": "integer"
-                },
-                "endDate": {
-                    "type": "string"
-                },
-                "featured": {
-                    "type": "boolean"
-                },
-                "features": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "githubUrl": {
+                "fileName": {
                     "type": "string"
                 },
-                "id": {
+                "fileSize": {
                     "type": "integer"
                 },
-                "imageFile": {
-                    "description": "Associations",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
-                        }
-                    ]
+                "fileType": {
+                    "type": "string"
                 },
-                "imageFileId": {
+                "id": {
                     "type": "integer"
                 },
-                "isOngoing": {
-                    "type": "boolean"
-                },
-                "learnings": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "liveUrl": {
+                "mimeType": {
                     "type": "string"
                 },
-                "longDescription": {
+                "url": {
+                    "description": "Computed field",
                     "type": "string"
-                },
-                "role": {
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Tag": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
                     "type": "string"
                 },
-                "startDate": {
-                    "type": "string"
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "teamSize": {
+                "id": {
                     "type": "integer"
                 },
-                "technologies": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.Skill"
-                    }
-                },
-                "title": {
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "example": "event-driven"
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "usage": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer",
+                        "format": "int64"
+                    }
+                },
+                "usageCount": {
+                    "description": "UsageCount is the number of tagged records; Usage splits it by entity type",
+                    "type": "integer",
+                    "example": 5
                 }
             }
         },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.Profile": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Testimonial": {
             "type": "object",
             "required": [
-                "name"
+                "authorName",
+                "quote"
             ],
             "properties": {
+                "approved": {
+                    "type": "boolean"
+                },
+                "authorName": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Jane Smith"
+                },
+                "authorRole": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Engineering Manager"
+                },
                 "avatarFile": {
-                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
+                    "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.StorageFile"
                 },
                 "avatarFileId": {
-                    "type": "integer"
+                    "type": "integer",
+                    "example": 12
                 },
-                "createdAt": {
-                    "type": "string"
+                "company": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Tech Corp"
                 },
-                "email": {
+                "createdAt": {
                     "type": "string"
                 },
-                "github": {
-                    "type": "string"
+                "displayOrder": {
+                    "type": "integer"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "linkedin": {
-                    "type": "string"
+                "portfolioProjectId": {
+                    "type": "integer"
                 },
-                "location": {
-                    "type": "string"
+                "quote": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "example": "A thoughtful engineer who leaves every system better than they found it."
+                },
+                "relationship": {
+                    "type": "string",
+                    "enum": [
+                        "manager",
+                        "colleague",
+                        "report",
+                        "client",
+                        "mentor",
+                        "other"
+                    ],
+                    "example": "manager"
                 },
-                "name": {
+                "updatedAt": {
                     "type": "string"
                 },
-                "phone": {
-                    "type": "string"
+                "workExperienceId": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.TranslationRequest": {
+            "type": "object",
+            "required": [
+                "fields"
+            ],
+            "properties": {
+                "fields": {
+                    "type": "object"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Translations": {
+            "type": "object",
+            "properties": {
+                "entity": {
+                    "type": "string",
+                    "example": "portfolio_project"
                 },
-                "resumeFile": {
-                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.StorageFile"
+                "entityId": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "resumeFileId": {
+                "locales": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.ValueCount": {
+            "type": "object",
+            "properties": {
+                "count": {
                     "type": "integer"
                 },
-                "tagline": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "updatedAt": {
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.Skill": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Visibility": {
             "type": "object",
             "required": [
-                "skill",
-                "skillTypeId"
+                "visible"
+            ],
+            "properties": {
+                "visible": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_models.Webhook": {
+            "type": "object",
+            "required": [
+                "events",
+                "name",
+                "url"
             ],
             "properties": {
                 "createdAt": {
                     "type": "string"
                 },
-                "displayOrder": {
-                    "type": "integer"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "isVisible": {
+                "disabled": {
                     "type": "boolean"
                 },
-                "skill": {
-                    "type": "string"
-                },
-                "skillType": {
-                    "$ref": "#/definitions/github_com_GunarsK-portfolio_portfolio-common_models.SkillType"
+                "events": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "skillTypeId": {
+                "id": {
                     "type": "integer"
                 },
-                "type": {
-                    "description": "Computed field",
-                    "type": "string"
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "secret": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "minLength": 16
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500
                 }
             }
         },
-        "github_com_GunarsK-portfolio_admin-api_internal_models.SkillType": {
+        "github_com_GunarsK-portfolio_admin-api_internal_models.WebhookDelivery": {
             "type": "object",
-            "required": [
-                "name"
-            ],
             "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
                 "createdAt": {
                     "type": "string"
                 },
-                "description": {
+                "deliveredAt": {
                     "type": "string"
                 },
-                "displayOrder": {
-                    "type": "integer"
+                "eventId": {
+                    "type": "string"
+                },
+                "eventType": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "name": {
+                "lastError": {
+                    "type": "string"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "payload": {
+                    "type": "object"
+                },
+                "responseStatus": {
+                    "type": "integer"
+                },
+                "status": {
                     "type": "string"
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "webhookId": {
+                    "type": "integer"
                 }
             }
         },
@@ -3653,6 +10682,55 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_GunarsK-portfolio_admin-api_internal_problem.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "description": "Field is the JSON path of the field, e.g. \"events[1]\" or \"fileId\"",
+                    "type": "string",
+                    "example": "fileId"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "is required"
+                }
+            }
+        },
+        "github_com_GunarsK-portfolio_admin-api_internal_problem.Problem": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "example": "invalid id"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/portfolio/skills/abc"
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "5f0c6f0e-3c1a-4c55-9d8e-2f7a0b1c9e21"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
         "github_com_GunarsK-portfolio_portfolio-common_models.Image": {
             "type": "object",
             "properties": {
@@ -3988,6 +11066,123 @@ const docTemplate = `{
                 }
             }
         },
+        "internal_handlers.articleRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "coverFile": {
+                    "description": "Associations",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.StorageFile"
+                        }
+                    ]
+                },
+                "coverFileId": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "publishedAt": {
+                    "type": "string"
+                },
+                "skillIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "skills": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.ArticleSkill"
+                    }
+                },
+                "slug": {
+                    "type": "string",
+                    "example": "replacing-cron-jobs-with-an-outbox"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "draft",
+                        "published",
+                        "archived"
+                    ],
+                    "example": "published"
+                },
+                "summary": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "How change events stopped getting lost between the database and the broker."
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200,
+                    "example": "Replacing cron jobs with an outbox"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "internal_handlers.dependentsProblem": {
+            "type": "object",
+            "properties": {
+                "dependents": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_models.Dependent"
+                    }
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "invalid id"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_GunarsK-portfolio_admin-api_internal_problem.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/v1/portfolio/skills/abc"
+                },
+                "requestId": {
+                    "type": "string",
+                    "example": "5f0c6f0e-3c1a-4c55-9d8e-2f7a0b1c9e21"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Bad Request"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "about:blank"
+                }
+            }
+        },
         "internal_handlers.miniatureProjectRequest": {
             "type": "object",
             "required": [
@@ -0,0 +1,224 @@
+// Package cache invalidates the public API's cached portfolio data after admin
+// changes. Each content change event maps to a set of cache keys (for example
+// portfolio:projects and portfolio:project:42) that are deleted in Redis and
+// announced on a pub/sub channel for in-process caches.
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// DefaultChannel is the pub/sub channel invalidated keys are announced on
+const DefaultChannel = "cache:invalidate"
+
+// IDPlaceholder in a key template is replaced with the changed entity's ID
+const IDPlaceholder = "{id}"
+
+const defaultQueueSize = 256
+
+// ErrUnknownEntity is returned for entities without a key mapping
+var ErrUnknownEntity = errors.New("unknown cache entity")
+
+// ErrInvalidKey is returned for keys outside the public API namespaces
+var ErrInvalidKey = errors.New("invalid cache key")
+
+// Namespaces are the key prefixes owned by the public API. Redis is shared
+// with other services, so keys and patterns outside them are never touched.
+var Namespaces = []string{"portfolio:", "miniatures:", "blog:"}
+
+// DefaultKeys maps entity types to the public API cache keys they affect.
+// Skills appear on projects and themes list their projects, so those lists
+// are invalidated too. Tags appear on every taggable record, so a tag change
+// invalidates all of them.
+var DefaultKeys = map[string][]string{
+	models.EntityProfile:            {"portfolio:profile"},
+	models.EntityWorkExperience:     {"portfolio:experience", "portfolio:experience:{id}"},
+	models.EntityCertification:      {"portfolio:certifications", "portfolio:certification:{id}"},
+	models.EntityEducation:          {"portfolio:education", "portfolio:education:{id}"},
+	models.EntityTestimonial:        {"portfolio:testimonials", "portfolio:testimonial:{id}"},
+	models.EntitySkill:              {"portfolio:skills", "portfolio:projects"},
+	models.EntitySkillType:          {"portfolio:skills"},
+	models.EntityPortfolioProject:   {"portfolio:projects", "portfolio:project:{id}"},
+	models.EntityMiniatureTheme:     {"miniatures:themes", "miniatures:theme:{id}"},
+	models.EntityMiniatureProject:   {"miniatures:projects", "miniatures:project:{id}", "miniatures:themes"},
+	models.EntityMiniaturePaint:     {"miniatures:paints", "miniatures:paint:{id}"},
+	models.EntityMiniatureTechnique: {"miniatures:techniques"},
+	models.EntityFile:               {"miniatures:projects"},
+	models.EntityArticle:            {"blog:articles", "blog:article:{id}"},
+	models.EntityTag: {
+		"portfolio:projects", "portfolio:project:*",
+		"miniatures:projects", "miniatures:project:*",
+		"miniatures:themes", "miniatures:theme:*",
+		"blog:articles", "blog:article:*",
+	},
+}
+
+// Store removes keys from the shared cache. Keys may contain * to match
+// every key with that pattern.
+type Store interface {
+	Invalidate(ctx context.Context, keys []string) error
+}
+
+// Config configures key mapping
+type Config struct {
+	// Keys replaces the default key templates of the listed entities
+	Keys map[string][]string
+}
+
+// ValidateKeys checks that key overrides only name known entities and keys
+// inside Namespaces
+func ValidateKeys(keys map[string][]string) error {
+	for entity, templates := range keys {
+		if _, ok := DefaultKeys[entity]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownEntity, entity)
+		}
+		for _, template := range templates {
+			if !ValidKey(template) {
+				return fmt.Errorf("%w: %s", ErrInvalidKey, template)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidKey reports whether key (or pattern) starts with one of Namespaces
+// followed by at least one literal character, so bare and leading wildcards
+// such as * or *:projects are rejected
+func ValidKey(key string) bool {
+	for _, namespace := range Namespaces {
+		rest, ok := strings.CutPrefix(key, namespace)
+		if ok && rest != "" && !strings.HasPrefix(rest, "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// Invalidator turns content change events into cache invalidations
+type Invalidator struct {
+	store  Store
+	keys   map[string][]string
+	queue  chan events.Event
+	logger *slog.Logger
+}
+
+// NewInvalidator creates an invalidator. A nil logger falls back to slog.Default().
+func NewInvalidator(store Store, cfg Config, logger *slog.Logger) *Invalidator {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	keys := make(map[string][]string, len(DefaultKeys))
+	for entity, templates := range DefaultKeys {
+		keys[entity] = templates
+	}
+	for entity, templates := range cfg.Keys {
+		keys[entity] = templates
+	}
+
+	return &Invalidator{
+		store:  store,
+		keys:   keys,
+		queue:  make(chan events.Event, defaultQueueSize),
+		logger: logger,
+	}
+}
+
+// Publish implements events.Publisher. The event is queued for Run; when the
+// queue is full the event is dropped and logged rather than blocking the request.
+func (i *Invalidator) Publish(_ context.Context, event events.Event) {
+	select {
+	case i.queue <- event:
+	default:
+		i.logger.Warn("Cache invalidation queue full, dropping event", "eventId", event.ID, "type", event.Type)
+	}
+}
+
+// Run processes queued events until ctx is cancelled
+func (i *Invalidator) Run(ctx context.Context) {
+	i.logger.Info("Cache invalidator started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			i.logger.Info("Cache invalidator stopped")
+			return
+		case event := <-i.queue:
+			keys := i.KeysFor(event.Entity, event.EntityID)
+			if len(keys) == 0 {
+				continue
+			}
+			if err := i.store.Invalidate(ctx, keys); err != nil {
+				i.logger.Error("Failed to invalidate cache", "eventId", event.ID, "type", event.Type, "keys", keys, "error", err)
+			}
+		}
+	}
+}
+
+// KeysFor returns the cache keys affected by a change to an entity.
+// Templates containing {id} are skipped when the ID is unknown (0).
+func (i *Invalidator) KeysFor(entity string, id int64) []string {
+	var keys []string
+	for _, template := range i.keys[entity] {
+		if strings.Contains(template, IDPlaceholder) {
+			if id == 0 {
+				continue
+			}
+			template = strings.ReplaceAll(template, IDPlaceholder, strconv.FormatInt(id, 10))
+		}
+		if !slices.Contains(keys, template) {
+			keys = append(keys, template)
+		}
+	}
+	return keys
+}
+
+// Purge invalidates every key of the given entities plus any explicit keys and
+// returns what was invalidated. Per-ID templates become * patterns. Explicit
+// keys must pass ValidKey. With no entities and no keys, every mapped key is
+// purged.
+func (i *Invalidator) Purge(ctx context.Context, entities, keys []string) ([]string, error) {
+	for _, key := range keys {
+		if !ValidKey(key) {
+			return nil, fmt.Errorf("%w: %s must start with one of %s", ErrInvalidKey, key, strings.Join(Namespaces, ", "))
+		}
+	}
+	if len(entities) == 0 && len(keys) == 0 {
+		for entity := range i.keys {
+			entities = append(entities, entity)
+		}
+		slices.Sort(entities)
+	}
+
+	var purged []string
+	add := func(key string) {
+		if !slices.Contains(purged, key) {
+			purged = append(purged, key)
+		}
+	}
+	for _, entity := range entities {
+		templates, ok := i.keys[entity]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEntity, entity)
+		}
+		for _, template := range templates {
+			add(strings.ReplaceAll(template, IDPlaceholder, "*"))
+		}
+	}
+	for _, key := range keys {
+		add(key)
+	}
+
+	if err := i.store.Invalidate(ctx, purged); err != nil {
+		return nil, err
+	}
+	return purged, nil
+}
@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+type fakeStore struct {
+	calls chan []string
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{calls: make(chan []string, 10)}
+}
+
+func (s *fakeStore) Invalidate(ctx context.Context, keys []string) error {
+	s.calls <- keys
+	return nil
+}
+
+func TestKeysFor(t *testing.T) {
+	inv := NewInvalidator(newFakeStore(), Config{}, nil)
+
+	tests := []struct {
+		entity string
+		id     int64
+		want   []string
+	}{
+		{models.EntityPortfolioProject, 42, []string{"portfolio:projects", "portfolio:project:42"}},
+		{models.EntityMiniatureProject, 7, []string{"miniatures:projects", "miniatures:project:7", "miniatures:themes"}},
+		{models.EntityProfile, 0, []string{"portfolio:profile"}},
+		{models.EntityCertification, 0, []string{"portfolio:certifications"}},
+		{models.EntityTag, 5, []string{
+			"portfolio:projects", "portfolio:project:*", "miniatures:projects", "miniatures:project:*",
+			"miniatures:themes", "miniatures:theme:*", "blog:articles", "blog:article:*",
+		}},
+		{"unknown", 1, nil},
+	}
+
+	for _, tt := range tests {
+		if got := inv.KeysFor(tt.entity, tt.id); !slices.Equal(got, tt.want) {
+			t.Errorf("KeysFor(%s, %d) = %v, want %v", tt.entity, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestKeysFor_ConfiguredOverride(t *testing.T) {
+	inv := NewInvalidator(newFakeStore(), Config{Keys: map[string][]string{
+		models.EntitySkill: {"portfolio:skill:{id}"},
+	}}, nil)
+
+	if got := inv.KeysFor(models.EntitySkill, 3); !slices.Equal(got, []string{"portfolio:skill:3"}) {
+		t.Errorf("KeysFor(skill, 3) = %v, want [portfolio:skill:3]", got)
+	}
+	// Entities without overrides keep their defaults
+	if got := inv.KeysFor(models.EntitySkillType, 1); !slices.Equal(got, []string{"portfolio:skills"}) {
+		t.Errorf("KeysFor(skill_type, 1) = %v, want [portfolio:skills]", got)
+	}
+
+	if err := ValidateKeys(map[string][]string{"projects": {"x"}}); !errors.Is(err, ErrUnknownEntity) {
+		t.Errorf("ValidateKeys() error = %v, want ErrUnknownEntity", err)
+	}
+	if err := ValidateKeys(map[string][]string{models.EntitySkill: {"sessions:{id}"}}); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("ValidateKeys() error = %v, want ErrInvalidKey", err)
+	}
+}
+
+func TestValidKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"portfolio:projects", true},
+		{"miniatures:project:*", true},
+		{"blog:article:42", true},
+		{"*", false},
+		{"*:projects", false},
+		{"portfolio:", false},
+		{"portfolio:*", false},
+		{"sessions:abc", false},
+		{"blog", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidKey(tt.key); got != tt.want {
+			t.Errorf("ValidKey(%q) = %v, want %v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestRun_InvalidatesKeysForEvent(t *testing.T) {
+	store := newFakeStore()
+	inv := NewInvalidator(store, Config{}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go inv.Run(ctx)
+
+	inv.Publish(ctx, events.New(models.EntityMiniaturePaint, events.ActionDeleted, 9, "/miniatures/paints/9"))
+
+	select {
+	case keys := <-store.calls:
+		if !slices.Equal(keys, []string{"miniatures:paints", "miniatures:paint:9"}) {
+			t.Errorf("invalidated %v, want [miniatures:paints miniatures:paint:9]", keys)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no invalidation within 1s")
+	}
+}
+
+func TestPurge(t *testing.T) {
+	store := newFakeStore()
+	inv := NewInvalidator(store, Config{}, nil)
+
+	purged, err := inv.Purge(context.Background(), []string{models.EntityPortfolioProject}, []string{"blog:feed"})
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+	want := []string{"portfolio:projects", "portfolio:project:*", "blog:feed"}
+	if !slices.Equal(purged, want) {
+		t.Errorf("Purge() = %v, want %v", purged, want)
+	}
+	if keys := <-store.calls; !slices.Equal(keys, want) {
+		t.Errorf("store invalidated %v, want %v", keys, want)
+	}
+
+	all, err := inv.Purge(context.Background(), nil, nil)
+	if err != nil {
+		t.Fatalf("Purge(all) error = %v", err)
+	}
+	if !slices.Contains(all, "portfolio:profile") || !slices.Contains(all, "miniatures:theme:*") {
+		t.Errorf("Purge(all) = %v, want every mapped key", all)
+	}
+
+	if _, err := inv.Purge(context.Background(), []string{"nope"}, nil); !errors.Is(err, ErrUnknownEntity) {
+		t.Errorf("Purge(unknown) error = %v, want ErrUnknownEntity", err)
+	}
+	if _, err := inv.Purge(context.Background(), nil, []string{"*"}); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Purge(*) error = %v, want ErrInvalidKey", err)
+	}
+}
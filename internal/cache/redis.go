@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const scanBatchSize = 500
+
+// RedisStore deletes keys in Redis and publishes each invalidated key (or
+// pattern) on a channel so subscribers can drop in-process copies
+type RedisStore struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewRedisStore creates a store. An empty channel uses DefaultChannel.
+func NewRedisStore(client *redis.Client, channel string) *RedisStore {
+	if channel == "" {
+		channel = DefaultChannel
+	}
+	return &RedisStore{client: client, channel: channel}
+}
+
+// Invalidate implements Store. Keys outside Namespaces are refused before
+// anything is deleted.
+func (s *RedisStore) Invalidate(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		if !ValidKey(key) {
+			return fmt.Errorf("%w: %s", ErrInvalidKey, key)
+		}
+	}
+
+	var exact []string
+	for _, key := range keys {
+		if !strings.Contains(key, "*") {
+			exact = append(exact, key)
+			continue
+		}
+		if err := s.deletePattern(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	pipe := s.client.Pipeline()
+	if len(exact) > 0 {
+		pipe.Del(ctx, exact...)
+	}
+	for _, key := range keys {
+		pipe.Publish(ctx, s.channel, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to invalidate cache keys: %w", err)
+	}
+	return nil
+}
+
+// deletePattern removes every key matching pattern using SCAN (never KEYS)
+func (s *RedisStore) deletePattern(ctx context.Context, pattern string) error {
+	iter := s.client.Scan(ctx, 0, pattern, scanBatchSize).Iterator()
+	var batch []string
+	for iter.Next(ctx) {
+		batch = append(batch, iter.Val())
+		if len(batch) == scanBatchSize {
+			if err := s.client.Del(ctx, batch...).Err(); err != nil {
+				return fmt.Errorf("failed to delete cache keys matching %s: %w", pattern, err)
+			}
+			batch = batch[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to scan cache keys matching %s: %w", pattern, err)
+	}
+	if len(batch) > 0 {
+		if err := s.client.Del(ctx, batch...).Err(); err != nil {
+			return fmt.Errorf("failed to delete cache keys matching %s: %w", pattern, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package certexpiry
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/notifier"
+)
+
+// Reminder event names
+const (
+	EventExpiring = "certification.expiring"
+	EventExpired  = "certification.expired"
+)
+
+// DefaultInterval is used when no positive check interval is configured
+const DefaultInterval = 24 * time.Hour
+
+// expiredThreshold is the threshold recorded for the reminder sent once a
+// certification has expired
+const expiredThreshold = 0
+
+// Store is the persistence the checker needs (implemented by repository.Repository)
+type Store interface {
+	GetAllCertifications(ctx context.Context) ([]models.Certification, error)
+	GetCertificationReminders(ctx context.Context) ([]models.CertificationReminder, error)
+	CreateCertificationReminder(ctx context.Context, reminder *models.CertificationReminder) error
+}
+
+// CheckerConfig configures the reminder checker
+type CheckerConfig struct {
+	// Interval between checks (DefaultInterval when zero)
+	Interval time.Duration
+	// Thresholds are day counts before expiry at which a reminder fires (e.g. 90, 30, 7).
+	// An additional reminder always fires once the certification has expired.
+	Thresholds []int
+}
+
+// Checker periodically scans certifications and sends one reminder per
+// threshold crossed. When several thresholds were crossed since the last
+// check only the closest one fires, so late-added certifications are not
+// flooded with stale reminders.
+type Checker struct {
+	store      Store
+	notifier   notifier.Notifier
+	interval   time.Duration
+	thresholds []int
+	logger     *slog.Logger
+	now        func() time.Time
+}
+
+// NewChecker creates a reminder checker. A nil logger falls back to slog.Default().
+func NewChecker(store Store, n notifier.Notifier, cfg CheckerConfig, logger *slog.Logger) *Checker {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	thresholds := make([]int, 0, len(cfg.Thresholds))
+	for _, t := range cfg.Thresholds {
+		if t > 0 {
+			thresholds = append(thresholds, t)
+		}
+	}
+	sort.Ints(thresholds)
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	return &Checker{
+		store:      store,
+		notifier:   n,
+		interval:   interval,
+		thresholds: thresholds,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Run checks immediately and then on every interval until ctx is cancelled
+func (c *Checker) Run(ctx context.Context) {
+	c.logger.Info("Certification reminder checker started", "interval", c.interval.String(), "thresholds", c.thresholds)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if sent, err := c.CheckOnce(ctx); err != nil {
+			c.logger.Error("Certification reminder check failed", "error", err)
+		} else if sent > 0 {
+			c.logger.Info("Certification reminders sent", "count", sent)
+		}
+
+		select {
+		case <-ctx.Done():
+			c.logger.Info("Certification reminder checker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+type reminderKey struct {
+	certificationID int64
+	threshold       int
+	expiryDate      string
+}
+
+// CheckOnce runs a single scan and returns the number of reminders sent.
+// A failed notification is not recorded, so it is retried on the next check.
+func (c *Checker) CheckOnce(ctx context.Context) (int, error) {
+	certs, err := c.store.GetAllCertifications(ctx)
+	if err != nil {
+		return 0, err
+	}
+	reminders, err := c.store.GetCertificationReminders(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := make(map[reminderKey]bool, len(reminders))
+	for _, r := range reminders {
+		sent[reminderKey{r.CertificationID, r.ThresholdDays, r.ExpiryDate}] = true
+	}
+
+	now := c.now()
+	count := 0
+	for _, cert := range certs {
+		days, ok := DaysUntilExpiry(cert.ExpiryDate, now)
+		if !ok {
+			continue
+		}
+		threshold, ok := c.threshold(days)
+		if !ok {
+			continue
+		}
+		expiryDate := (*cert.ExpiryDate)[:len(dateLayout)]
+		key := reminderKey{cert.ID, threshold, expiryDate}
+		if sent[key] {
+			continue
+		}
+
+		if err := c.notifier.Notify(ctx, c.notification(cert, expiryDate, days, threshold, now)); err != nil {
+			c.logger.Error("Failed to send certification reminder", "certificationId", cert.ID, "threshold", threshold, "error", err)
+			continue
+		}
+
+		reminder := &models.CertificationReminder{
+			CertificationID: cert.ID,
+			ThresholdDays:   threshold,
+			ExpiryDate:      expiryDate,
+			SentAt:          now,
+		}
+		if err := c.store.CreateCertificationReminder(ctx, reminder); err != nil {
+			c.logger.Error("Failed to record certification reminder", "certificationId", cert.ID, "threshold", threshold, "error", err)
+			continue
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// threshold returns the closest threshold crossed for the given days until expiry
+func (c *Checker) threshold(days int) (int, bool) {
+	if days < 0 {
+		return expiredThreshold, true
+	}
+	for _, t := range c.thresholds {
+		if days <= t {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+func (c *Checker) notification(cert models.Certification, expiryDate string, days, threshold int, now time.Time) notifier.Notification {
+	n := notifier.Notification{
+		Event: EventExpiring,
+		Data: map[string]any{
+			"certificationId": cert.ID,
+			"name":            cert.Name,
+			"issuer":          cert.Issuer,
+			"expiryDate":      expiryDate,
+			"daysUntilExpiry": days,
+			"thresholdDays":   threshold,
+		},
+		Time: now,
+	}
+	if days < 0 {
+		n.Event = EventExpired
+		n.Subject = fmt.Sprintf("Certification expired: %s", cert.Name)
+		n.Message = fmt.Sprintf("%s (%s) expired on %s.", cert.Name, cert.Issuer, expiryDate)
+		return n
+	}
+	n.Subject = fmt.Sprintf("Certification expiring in %d days: %s", days, cert.Name)
+	n.Message = fmt.Sprintf("%s (%s) expires on %s (%d days left).", cert.Name, cert.Issuer, expiryDate, days)
+	return n
+}
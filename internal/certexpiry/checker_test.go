@@ -0,0 +1,116 @@
+package certexpiry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/notifier"
+)
+
+type fakeStore struct {
+	certs     []models.Certification
+	reminders []models.CertificationReminder
+}
+
+func (s *fakeStore) GetAllCertifications(ctx context.Context) ([]models.Certification, error) {
+	return s.certs, nil
+}
+
+func (s *fakeStore) GetCertificationReminders(ctx context.Context) ([]models.CertificationReminder, error) {
+	return s.reminders, nil
+}
+
+func (s *fakeStore) CreateCertificationReminder(ctx context.Context, reminder *models.CertificationReminder) error {
+	s.reminders = append(s.reminders, *reminder)
+	return nil
+}
+
+type fakeNotifier struct {
+	sent []notifier.Notification
+	err  error
+}
+
+func (n *fakeNotifier) Notify(ctx context.Context, notification notifier.Notification) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+func newTestChecker(store Store, n notifier.Notifier, now time.Time) *Checker {
+	checker := NewChecker(store, n, CheckerConfig{Thresholds: []int{90, 30, 7}}, nil)
+	checker.now = func() time.Time { return now }
+	return checker
+}
+
+func TestCheckOnce_FiresClosestThresholdOnce(t *testing.T) {
+	now := time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)
+	store := &fakeStore{certs: []models.Certification{
+		{ID: 1, Name: "Far", ExpiryDate: dateIn(now, 200)},
+		{ID: 2, Name: "Soon", ExpiryDate: dateIn(now, 20)},
+		{ID: 3, Name: "Gone", ExpiryDate: dateIn(now, -3)},
+		{ID: 4, Name: "Forever"},
+	}}
+	n := &fakeNotifier{}
+	checker := newTestChecker(store, n, now)
+
+	sent, err := checker.CheckOnce(context.Background())
+	if err != nil {
+		t.Fatalf("CheckOnce() error = %v", err)
+	}
+	if sent != 2 {
+		t.Fatalf("CheckOnce() sent = %d, want 2", sent)
+	}
+	if store.reminders[0].CertificationID != 2 || store.reminders[0].ThresholdDays != 30 {
+		t.Errorf("first reminder = %+v, want certification 2 at 30 days", store.reminders[0])
+	}
+	if n.sent[1].Event != EventExpired || store.reminders[1].ThresholdDays != expiredThreshold {
+		t.Errorf("second reminder event = %s, want %s", n.sent[1].Event, EventExpired)
+	}
+
+	// Same thresholds must not fire again
+	if sent, _ := checker.CheckOnce(context.Background()); sent != 0 {
+		t.Errorf("second CheckOnce() sent = %d, want 0", sent)
+	}
+
+	// Crossing the next threshold fires again
+	checker.now = func() time.Time { return now.AddDate(0, 0, 14) }
+	if sent, _ := checker.CheckOnce(context.Background()); sent != 1 {
+		t.Errorf("CheckOnce() after 7-day threshold sent = %d, want 1", sent)
+	}
+}
+
+func TestCheckOnce_RenewalRearmsReminders(t *testing.T) {
+	now := time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)
+	store := &fakeStore{certs: []models.Certification{{ID: 1, Name: "Cert", ExpiryDate: dateIn(now, 5)}}}
+	checker := newTestChecker(store, &fakeNotifier{}, now)
+
+	if sent, _ := checker.CheckOnce(context.Background()); sent != 1 {
+		t.Fatalf("CheckOnce() sent = %d, want 1", sent)
+	}
+
+	store.certs[0].ExpiryDate = dateIn(now, 6)
+	if sent, _ := checker.CheckOnce(context.Background()); sent != 1 {
+		t.Errorf("CheckOnce() after renewal sent = %d, want 1", sent)
+	}
+}
+
+func TestCheckOnce_NotifierFailureIsRetried(t *testing.T) {
+	now := time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC)
+	store := &fakeStore{certs: []models.Certification{{ID: 1, Name: "Cert", ExpiryDate: dateIn(now, 5)}}}
+	n := &fakeNotifier{err: errors.New("smtp down")}
+	checker := newTestChecker(store, n, now)
+
+	if sent, _ := checker.CheckOnce(context.Background()); sent != 0 || len(store.reminders) != 0 {
+		t.Fatalf("CheckOnce() recorded reminder despite notifier failure")
+	}
+
+	n.err = nil
+	if sent, _ := checker.CheckOnce(context.Background()); sent != 1 {
+		t.Errorf("CheckOnce() retry sent = %d, want 1", sent)
+	}
+}
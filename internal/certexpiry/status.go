@@ -0,0 +1,66 @@
+// Package certexpiry computes certification expiry status and runs the
+// background reminder checker.
+package certexpiry
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Certification expiry statuses
+const (
+	StatusActive   = "active"
+	StatusExpiring = "expiring"
+	StatusExpired  = "expired"
+)
+
+// DefaultExpiringWindowDays is how many days before expiry a certification
+// is reported as expiring
+const DefaultExpiringWindowDays = 90
+
+const dateLayout = "2006-01-02"
+
+// DaysUntilExpiry returns the number of whole days from now until the expiry date.
+// Zero means the certification expires today, negative values mean it has expired.
+// ok is false when the certification has no (parseable) expiry date.
+func DaysUntilExpiry(expiryDate *string, now time.Time) (days int, ok bool) {
+	if expiryDate == nil || len(*expiryDate) < len(dateLayout) {
+		return 0, false
+	}
+	// Accept both plain dates and timestamps (database drivers may return either)
+	expiry, err := time.Parse(dateLayout, (*expiryDate)[:len(dateLayout)])
+	if err != nil {
+		return 0, false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(expiry.Sub(today).Hours() / 24), true
+}
+
+// Status computes the expiry status. Certifications without an expiry date never expire.
+func Status(expiryDate *string, now time.Time, windowDays int) string {
+	days, ok := DaysUntilExpiry(expiryDate, now)
+	switch {
+	case !ok:
+		return StatusActive
+	case days < 0:
+		return StatusExpired
+	case days <= windowDays:
+		return StatusExpiring
+	default:
+		return StatusActive
+	}
+}
+
+// ParseDays parses a day count such as "90d" or "90"
+func ParseDays(s string) (int, error) {
+	days, err := strconv.Atoi(strings.TrimSuffix(strings.TrimSpace(s), "d"))
+	if err != nil {
+		return 0, errors.New("expected a day count such as 90d")
+	}
+	if days < 0 {
+		return 0, errors.New("day count must not be negative")
+	}
+	return days, nil
+}
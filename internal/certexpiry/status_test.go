@@ -0,0 +1,67 @@
+package certexpiry
+
+import (
+	"testing"
+	"time"
+)
+
+func dateIn(now time.Time, days int) *string {
+	s := now.AddDate(0, 0, days).Format(dateLayout)
+	return &s
+}
+
+func TestStatus(t *testing.T) {
+	now := time.Date(2026, 3, 15, 18, 30, 0, 0, time.UTC)
+	timestamp := "2026-03-20T00:00:00Z"
+	invalid := "not-a-date"
+
+	tests := []struct {
+		name       string
+		expiryDate *string
+		want       string
+	}{
+		{"no expiry date", nil, StatusActive},
+		{"unparseable expiry date", &invalid, StatusActive},
+		{"far in the future", dateIn(now, 365), StatusActive},
+		{"just outside window", dateIn(now, 91), StatusActive},
+		{"at window edge", dateIn(now, 90), StatusExpiring},
+		{"expires today", dateIn(now, 0), StatusExpiring},
+		{"timestamp value", &timestamp, StatusExpiring},
+		{"expired yesterday", dateIn(now, -1), StatusExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Status(tt.expiryDate, now, DefaultExpiringWindowDays); got != tt.want {
+				t.Errorf("Status() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDays(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{"90d", 90, false},
+		{"30", 30, false},
+		{"0d", 0, false},
+		{"-1d", 0, true},
+		{"soon", 0, true},
+		{"", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDays(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDays(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDays(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
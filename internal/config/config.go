@@ -2,6 +2,11 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 
@@ -13,14 +18,155 @@ type Config struct {
 	common.ServiceConfig
 	JWTSecret   string `validate:"required,min=32"`
 	FilesAPIURL string `validate:"required,url"`
+
+	// CertExpiringWindowDays is how many days before expiry a certification is reported as expiring
+	CertExpiringWindowDays int `validate:"min=1"`
+	CertReminders          CertReminderConfig
+	Webhooks               WebhookConfig
+	Outbox                 OutboxConfig
+	Cache                  CacheConfig
+	Stream                 StreamConfig
+	Files                  FilesConfig
+
+	// ContentLocales are the languages of content: the first is the one records
+	// are written in, the others are translations
+	ContentLocales []string `validate:"min=1,unique,dive,min=2,max=10"`
+}
+
+// FilesConfig configures calls to files-api and orphaned file cleanup
+type FilesConfig struct {
+	// APIHealthURL overrides the files-api health endpoint (default /health on the FILES_API_URL host)
+	APIHealthURL      string        `validate:"omitempty,url"`
+	APITimeout        time.Duration `validate:"min=100ms"`
+	APIMaxRetries     int           `validate:"min=0,max=10"`
+	APIRetryBaseDelay time.Duration `validate:"min=10ms"`
+	// UploadMaxBytes caps upload-through requests; UploadTimeout bounds them end to end
+	UploadMaxBytes int64         `validate:"min=1"`
+	UploadTimeout  time.Duration `validate:"min=1s"`
+	// OrphanGracePeriod is how old an unreferenced file must be before it can be purged
+	OrphanGracePeriod time.Duration `validate:"min=1h"`
+}
+
+// StreamConfig configures the live change stream
+type StreamConfig struct {
+	// BufferSize is how many recent events are kept for Last-Event-ID resume
+	BufferSize        int           `validate:"min=1,max=100000"`
+	HeartbeatInterval time.Duration `validate:"min=1s"`
+}
+
+// CacheConfig configures public API cache invalidation in Redis
+type CacheConfig struct {
+	InvalidationEnabled bool
+	// Channel announces every invalidated key or pattern
+	Channel string `validate:"required"`
+	// Keys overrides the key templates of an entity, from CACHE_KEYS_<ENTITY>
+	Keys map[string][]string
+	// Redis is loaded only when invalidation is enabled
+	Redis *common.RedisConfig
+}
+
+// OutboxConfig configures the relay that publishes outbox events to RabbitMQ
+type OutboxConfig struct {
+	RelayEnabled bool
+	PollInterval time.Duration `validate:"min=100ms"`
+	BatchSize    int           `validate:"min=1,max=1000"`
+	// Retention is how long published events are kept (negative keeps them forever)
+	Retention time.Duration
+	// RabbitMQ is loaded only when the relay is enabled
+	RabbitMQ *common.RabbitMQConfig
+}
+
+// WebhookConfig configures outbound webhook delivery and retries
+type WebhookConfig struct {
+	MaxAttempts    int           `validate:"min=1,max=20"`
+	RetryBaseDelay time.Duration `validate:"min=1s"`
+	RetryMaxDelay  time.Duration `validate:"gtefield=RetryBaseDelay"`
+	PollInterval   time.Duration `validate:"min=1s"`
+	Timeout        time.Duration `validate:"min=1s"`
+}
+
+// CertReminderConfig configures the background certification expiry reminder checker
+type CertReminderConfig struct {
+	Enabled    bool
+	Interval   time.Duration `validate:"min=1m"`
+	Thresholds []int         `validate:"dive,min=1"`
+	Notifiers  []string      `validate:"dive,oneof=log smtp webhook"`
+	WebhookURL string        `validate:"omitempty,url"`
+	SMTP       SMTPConfig
+}
+
+// SMTPConfig holds the mail relay used by the smtp notifier.
+// Locally this points at a stand-in relay such as Mailpit (no auth).
+type SMTPConfig struct {
+	Host     string
+	Port     int `validate:"min=1,max=65535"`
+	Username string
+	Password string
+	From     string   `validate:"omitempty,email"`
+	To       []string `validate:"dive,email"`
 }
 
 func Load() *Config {
 	cfg := &Config{
-		DatabaseConfig: common.NewDatabaseConfig(),
-		ServiceConfig:  common.NewServiceConfig(8083),
-		JWTSecret:      common.GetEnvRequired("JWT_SECRET"),
-		FilesAPIURL:    common.GetEnvRequired("FILES_API_URL"),
+		DatabaseConfig:         common.NewDatabaseConfig(),
+		ServiceConfig:          common.NewServiceConfig(8083),
+		JWTSecret:              common.GetEnvRequired("JWT_SECRET"),
+		FilesAPIURL:            common.GetEnvRequired("FILES_API_URL"),
+		CertExpiringWindowDays: common.GetEnvInt("CERT_EXPIRING_WINDOW_DAYS", 90),
+		ContentLocales:         parseList(strings.ToLower(common.GetEnv("CONTENT_LOCALES", "en,lv"))),
+		CertReminders: CertReminderConfig{
+			Enabled:    common.GetEnvBool("CERT_REMINDERS_ENABLED", false),
+			Interval:   common.GetEnvDuration("CERT_REMINDER_INTERVAL", 24*time.Hour),
+			Thresholds: parseIntList("CERT_REMINDER_THRESHOLDS", common.GetEnv("CERT_REMINDER_THRESHOLDS", "90,30,7")),
+			Notifiers:  parseList(common.GetEnv("CERT_REMINDER_NOTIFIERS", "log")),
+			WebhookURL: common.GetEnv("CERT_REMINDER_WEBHOOK_URL", ""),
+			SMTP: SMTPConfig{
+				Host:     common.GetEnv("SMTP_HOST", ""),
+				Port:     common.GetEnvInt("SMTP_PORT", 1025),
+				Username: common.GetEnv("SMTP_USERNAME", ""),
+				Password: common.GetEnv("SMTP_PASSWORD", ""),
+				From:     common.GetEnv("SMTP_FROM", ""),
+				To:       parseList(common.GetEnv("SMTP_TO", "")),
+			},
+		},
+		Webhooks: WebhookConfig{
+			MaxAttempts:    common.GetEnvInt("WEBHOOK_MAX_ATTEMPTS", 5),
+			RetryBaseDelay: common.GetEnvDuration("WEBHOOK_RETRY_BASE_DELAY", 30*time.Second),
+			RetryMaxDelay:  common.GetEnvDuration("WEBHOOK_RETRY_MAX_DELAY", time.Hour),
+			PollInterval:   common.GetEnvDuration("WEBHOOK_POLL_INTERVAL", 15*time.Second),
+			Timeout:        common.GetEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+		},
+		Outbox: OutboxConfig{
+			RelayEnabled: common.GetEnvBool("OUTBOX_RELAY_ENABLED", false),
+			PollInterval: common.GetEnvDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    common.GetEnvInt("OUTBOX_BATCH_SIZE", 100),
+			Retention:    common.GetEnvDuration("OUTBOX_RETENTION", 7*24*time.Hour),
+		},
+		Cache: CacheConfig{
+			InvalidationEnabled: common.GetEnvBool("CACHE_INVALIDATION_ENABLED", false),
+			Channel:             common.GetEnv("CACHE_INVALIDATION_CHANNEL", "cache:invalidate"),
+			Keys:                parseKeyOverrides("CACHE_KEYS_"),
+		},
+		Stream: StreamConfig{
+			BufferSize:        common.GetEnvInt("STREAM_BUFFER_SIZE", 1000),
+			HeartbeatInterval: common.GetEnvDuration("STREAM_HEARTBEAT_INTERVAL", 15*time.Second),
+		},
+		Files: FilesConfig{
+			APIHealthURL:      common.GetEnv("FILES_API_HEALTH_URL", ""),
+			APITimeout:        common.GetEnvDuration("FILES_API_TIMEOUT", 10*time.Second),
+			APIMaxRetries:     common.GetEnvInt("FILES_API_MAX_RETRIES", 2),
+			APIRetryBaseDelay: common.GetEnvDuration("FILES_API_RETRY_BASE_DELAY", 200*time.Millisecond),
+			UploadMaxBytes:    int64(common.GetEnvInt("FILES_UPLOAD_MAX_BYTES", 20<<20)),
+			UploadTimeout:     common.GetEnvDuration("FILES_UPLOAD_TIMEOUT", 2*time.Minute),
+			OrphanGracePeriod: common.GetEnvDuration("FILES_ORPHAN_GRACE_PERIOD", 24*time.Hour),
+		},
+	}
+	if cfg.Cache.InvalidationEnabled {
+		redisCfg := common.NewRedisConfig()
+		cfg.Cache.Redis = &redisCfg
+	}
+	if cfg.Outbox.RelayEnabled {
+		cfg.Outbox.RabbitMQ = newEventsRabbitMQConfig()
 	}
 
 	// Validate service-specific fields
@@ -28,6 +174,78 @@ func Load() *Config {
 	if err := validate.Struct(cfg); err != nil {
 		panic(fmt.Sprintf("Invalid configuration: %v", err))
 	}
+	if err := cfg.CertReminders.validate(); err != nil {
+		panic(fmt.Sprintf("Invalid configuration: %v", err))
+	}
 
 	return cfg
 }
+
+// newEventsRabbitMQConfig loads the broker settings for domain events.
+// EVENTS_RABBITMQ_* variables override the shared RABBITMQ_* ones. The exchange
+// and queue get their own defaults so events never land in another service's
+// queue, and publisher confirms are always on for at-least-once delivery.
+func newEventsRabbitMQConfig() *common.RabbitMQConfig {
+	rabbit := common.NewRabbitMQConfigWithPrefix("EVENTS_")
+	rabbit.Exchange = common.GetEnv("EVENTS_RABBITMQ_EXCHANGE", "admin_events")
+	rabbit.Queue = common.GetEnv("EVENTS_RABBITMQ_QUEUE", "admin_events")
+	rabbit.PublisherConfirms = true
+	return &rabbit
+}
+
+// validate checks settings required by the selected notifiers
+func (c CertReminderConfig) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if len(c.Notifiers) == 0 {
+		return fmt.Errorf("CERT_REMINDER_NOTIFIERS must list at least one notifier")
+	}
+	if slices.Contains(c.Notifiers, "smtp") && (c.SMTP.Host == "" || c.SMTP.From == "" || len(c.SMTP.To) == 0) {
+		return fmt.Errorf("smtp notifier requires SMTP_HOST, SMTP_FROM and SMTP_TO")
+	}
+	if slices.Contains(c.Notifiers, "webhook") && c.WebhookURL == "" {
+		return fmt.Errorf("webhook notifier requires CERT_REMINDER_WEBHOOK_URL")
+	}
+	return nil
+}
+
+// parseList splits a comma-separated value, dropping empty entries
+func parseList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			items = append(items, trimmed)
+		}
+	}
+	return items
+}
+
+// parseKeyOverrides collects comma-separated lists from variables starting with
+// prefix, keyed by the lower-cased remainder (CACHE_KEYS_SKILL_TYPE -> skill_type)
+func parseKeyOverrides(prefix string) map[string][]string {
+	overrides := make(map[string][]string)
+	for _, env := range os.Environ() {
+		name, value, _ := strings.Cut(env, "=")
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		overrides[strings.ToLower(strings.TrimPrefix(name, prefix))] = parseList(value)
+	}
+	return overrides
+}
+
+// parseIntList splits a comma-separated list of integers.
+// Panics on malformed entries so typos fail at startup.
+func parseIntList(key, value string) []int {
+	items := parseList(value)
+	ints := make([]int, 0, len(items))
+	for _, item := range items {
+		n, err := strconv.Atoi(item)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid integer %q in %s", item, key))
+		}
+		ints = append(ints, n)
+	}
+	return ints
+}
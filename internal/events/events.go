@@ -0,0 +1,101 @@
+// Package events defines the content change events emitted after successful
+// admin mutations, an in-process bus that fans them out to subscribers
+// (webhooks, cache invalidation, live streams) and the webhook event filters
+// that decide which deliveries an event creates.
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// Change actions
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+)
+
+// typePrefixes maps entity types to their public event name prefix
+var typePrefixes = map[string]string{
+	models.EntityProfile:            "portfolio.profile",
+	models.EntityWorkExperience:     "portfolio.experience",
+	models.EntityCertification:      "portfolio.certification",
+	models.EntityEducation:          "portfolio.education",
+	models.EntityTestimonial:        "portfolio.testimonial",
+	models.EntitySkill:              "portfolio.skill",
+	models.EntitySkillType:          "portfolio.skill_type",
+	models.EntityPortfolioProject:   "portfolio.project",
+	models.EntityMiniatureTheme:     "miniatures.theme",
+	models.EntityMiniatureProject:   "miniatures.project",
+	models.EntityMiniaturePaint:     "miniatures.paint",
+	models.EntityMiniatureTechnique: "miniatures.technique",
+	models.EntityFile:               "files.file",
+	models.EntityTag:                "content.tag",
+	models.EntityArticle:            "blog.article",
+}
+
+// Event is a content change notification, e.g. portfolio.project.updated
+type Event struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	Entity     string    `json:"entity"`
+	EntityID   int64     `json:"entityId,omitempty"`
+	Action     string    `json:"action"`
+	Path       string    `json:"path,omitempty"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// Type returns the event name for an entity change, e.g. miniatures.project.deleted
+func Type(entity, action string) string {
+	prefix, ok := typePrefixes[entity]
+	if !ok {
+		prefix = entity
+	}
+	return prefix + "." + action
+}
+
+// New creates an event with a fresh ID
+func New(entity, action string, entityID int64, path string) Event {
+	return Event{
+		ID:         uuid.NewString(),
+		Type:       Type(entity, action),
+		Entity:     entity,
+		EntityID:   entityID,
+		Action:     action,
+		Path:       path,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// Publisher receives events. Implementations must not block the caller:
+// events are emitted from request handlers after the change is committed.
+type Publisher interface {
+	Publish(ctx context.Context, event Event)
+}
+
+// Bus fans events out to every subscriber
+type Bus struct {
+	subscribers []Publisher
+}
+
+// NewBus creates a bus with the given subscribers
+func NewBus(subscribers ...Publisher) *Bus {
+	return &Bus{subscribers: subscribers}
+}
+
+// Subscribe adds a subscriber. Not safe for use after publishing has started.
+func (b *Bus) Subscribe(p Publisher) {
+	b.subscribers = append(b.subscribers, p)
+}
+
+// Publish implements Publisher
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	for _, s := range b.subscribers {
+		s.Publish(ctx, event)
+	}
+}
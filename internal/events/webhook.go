@@ -0,0 +1,52 @@
+package events
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// NewDeliveries returns a pending, immediately due delivery of the event for
+// every webhook subscribed to it. The repository records them with the change
+// and the webhooks dispatcher delivers them.
+func NewDeliveries(webhooks []models.Webhook, event Event, payload []byte) []models.WebhookDelivery {
+	var deliveries []models.WebhookDelivery
+	for _, webhook := range webhooks {
+		if webhook.Disabled || !Matches(webhook.Events, event.Type) {
+			continue
+		}
+		due := event.OccurredAt
+		deliveries = append(deliveries, models.WebhookDelivery{
+			WebhookID:     webhook.ID,
+			EventID:       event.ID,
+			EventType:     event.Type,
+			Payload:       payload,
+			Status:        models.WebhookDeliveryPending,
+			NextAttemptAt: &due,
+		})
+	}
+	return deliveries
+}
+
+// Matches reports whether an event type passes a webhook's event filters.
+// Filters are exact names, prefix wildcards such as "portfolio.*", or "*".
+func Matches(filters []string, eventType string) bool {
+	for _, filter := range filters {
+		switch {
+		case filter == "*", filter == eventType:
+			return true
+		case strings.HasSuffix(filter, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(filter, "*")):
+			return true
+		}
+	}
+	return false
+}
+
+// filterPattern accepts dotted lower-case event names with an optional trailing wildcard
+var filterPattern = regexp.MustCompile(`^[a-z_]+(\.[a-z_]+)*(\.\*)?$`)
+
+// ValidFilter reports whether an event filter is well-formed
+func ValidFilter(filter string) bool {
+	return filter == "*" || filterPattern.MatchString(filter)
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		filters []string
+		event   string
+		want    bool
+	}{
+		{[]string{"*"}, "portfolio.project.updated", true},
+		{[]string{"portfolio.project.updated"}, "portfolio.project.updated", true},
+		{[]string{"portfolio.*"}, "portfolio.skill.created", true},
+		{[]string{"portfolio.project.*"}, "portfolio.project_type.created", false},
+		{[]string{"miniatures.*"}, "portfolio.project.updated", false},
+		{[]string{"portfolio.project.created"}, "portfolio.project.updated", false},
+	}
+
+	for _, tt := range tests {
+		if got := Matches(tt.filters, tt.event); got != tt.want {
+			t.Errorf("Matches(%v, %s) = %v, want %v", tt.filters, tt.event, got, tt.want)
+		}
+	}
+}
+
+func TestNewDeliveries(t *testing.T) {
+	event := New(models.EntityPortfolioProject, ActionUpdated, 12, "/portfolio/projects/12")
+	deliveries := NewDeliveries([]models.Webhook{
+		{ID: 1, Events: []string{"portfolio.*"}},
+		{ID: 2, Events: []string{"miniatures.*"}},
+		{ID: 3, Events: []string{"*"}, Disabled: true},
+	}, event, []byte(`{}`))
+
+	if len(deliveries) != 1 || deliveries[0].WebhookID != 1 {
+		t.Fatalf("NewDeliveries() = %+v, want one delivery to webhook 1", deliveries)
+	}
+	delivery := deliveries[0]
+	if delivery.Status != models.WebhookDeliveryPending || delivery.NextAttemptAt == nil || !delivery.NextAttemptAt.Equal(event.OccurredAt) {
+		t.Errorf("delivery = %+v, want pending and due when the event occurred", delivery)
+	}
+	if delivery.EventID != event.ID || delivery.EventType != "portfolio.project.updated" {
+		t.Errorf("delivery event = %s %s, want %s portfolio.project.updated", delivery.EventID, delivery.EventType, event.ID)
+	}
+}
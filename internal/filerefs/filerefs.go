@@ -0,0 +1,110 @@
+// Package filerefs checks that file IDs sent by clients reference an existing
+// storage file of the right kind for where it is linked, so a typo or a wrong
+// upload is rejected with a clear reason instead of a foreign key error or a
+// PDF showing up as an avatar.
+package filerefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// Usage is a place a storage file can be linked
+type Usage string
+
+// Supported usages
+const (
+	UsageAvatar       Usage = "avatar"
+	UsageResume       Usage = "resume"
+	UsageProjectImage Usage = "project image"
+	UsageThemeCover   Usage = "theme cover"
+	UsageGalleryImage Usage = "gallery image"
+	UsageSocialImage  Usage = "social image"
+	UsageArticleCover Usage = "article cover"
+)
+
+// ErrInvalidReference is matched by every *Error
+var ErrInvalidReference = errors.New("invalid file reference")
+
+// Error describes why a file cannot be linked
+type Error struct {
+	Field  string
+	FileID int64
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s %d: %s", e.Field, e.FileID, e.Reason)
+}
+
+// Is reports whether target is ErrInvalidReference
+func (e *Error) Is(target error) bool {
+	return target == ErrInvalidReference
+}
+
+// Lookup loads storage file metadata
+type Lookup interface {
+	GetStorageFileByID(ctx context.Context, id int64) (*models.StorageFile, error)
+}
+
+// Validator checks file references against storage.files
+type Validator struct {
+	lookup Lookup
+}
+
+// NewValidator creates a validator
+func NewValidator(lookup Lookup) *Validator {
+	return &Validator{lookup: lookup}
+}
+
+// Validate returns an *Error when the file referenced by field does not exist
+// or cannot be used for usage. Other errors come from the lookup.
+func (v *Validator) Validate(ctx context.Context, field string, usage Usage, fileID int64) error {
+	file, err := v.lookup.GetStorageFileByID(ctx, fileID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &Error{Field: field, FileID: fileID, Reason: "file does not exist"}
+		}
+		return fmt.Errorf("failed to check %s: %w", field, err)
+	}
+	if reason := Check(file, usage); reason != "" {
+		return &Error{Field: field, FileID: fileID, Reason: reason}
+	}
+	return nil
+}
+
+// FileType returns the files-api category new uploads for usage are stored under
+func FileType(usage Usage) string {
+	if usage == UsageResume {
+		return "document"
+	}
+	return "image"
+}
+
+// Check returns why file cannot be used for usage, or "" when it can
+func Check(file *models.StorageFile, usage Usage) string {
+	mimeType := strings.ToLower(strings.TrimSpace(file.MimeType))
+	switch usage {
+	case UsageResume:
+		if mimeType != "application/pdf" {
+			return fmt.Sprintf("%s must be a PDF, got %s", usage, describe(mimeType))
+		}
+	default:
+		if !strings.HasPrefix(mimeType, "image/") {
+			return fmt.Sprintf("%s must be an image, got %s", usage, describe(mimeType))
+		}
+	}
+	return ""
+}
+
+func describe(mimeType string) string {
+	if mimeType == "" {
+		return "a file without a mime type"
+	}
+	return mimeType
+}
@@ -0,0 +1,69 @@
+package filerefs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"gorm.io/gorm"
+)
+
+type fakeLookup map[int64]*models.StorageFile
+
+func (l fakeLookup) GetStorageFileByID(ctx context.Context, id int64) (*models.StorageFile, error) {
+	if file, ok := l[id]; ok {
+		return file, nil
+	}
+	return nil, fmt.Errorf("failed to get storage file with id %d: %w", id, gorm.ErrRecordNotFound)
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		usage    Usage
+		mimeType string
+		ok       bool
+	}{
+		{UsageAvatar, "image/png", true},
+		{UsageThemeCover, "IMAGE/JPEG", true},
+		{UsageGalleryImage, "image/webp", true},
+		{UsageProjectImage, "application/pdf", false},
+		{UsageAvatar, "", false},
+		{UsageResume, "application/pdf", true},
+		{UsageResume, "image/png", false},
+	}
+
+	for _, tt := range tests {
+		reason := Check(&models.StorageFile{MimeType: tt.mimeType}, tt.usage)
+		if (reason == "") != tt.ok {
+			t.Errorf("Check(%q, %s) = %q, want ok %v", tt.mimeType, tt.usage, reason, tt.ok)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	v := NewValidator(fakeLookup{
+		1: {ID: 1, MimeType: "image/png"},
+		2: {ID: 2, MimeType: "application/pdf"},
+	})
+	ctx := context.Background()
+
+	if err := v.Validate(ctx, "fileId", UsageAvatar, 1); err != nil {
+		t.Errorf("Validate(image as avatar) error = %v", err)
+	}
+
+	err := v.Validate(ctx, "avatarFileId", UsageAvatar, 2)
+	if !errors.Is(err, ErrInvalidReference) {
+		t.Fatalf("Validate(pdf as avatar) error = %v, want ErrInvalidReference", err)
+	}
+	if want := "avatarFileId 2: avatar must be an image, got application/pdf"; err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+
+	err = v.Validate(ctx, "fileId", UsageResume, 3)
+	var refErr *Error
+	if !errors.As(err, &refErr) || refErr.Reason != "file does not exist" {
+		t.Errorf("Validate(missing) error = %v, want file does not exist", err)
+	}
+}
@@ -0,0 +1,338 @@
+// Package filesapi talks to files-api, which owns the objects in S3/MinIO and
+// their storage.files rows.
+//
+// Every call takes the caller's context: cancellation and deadlines propagate
+// to files-api, and the access token set with WithToken is forwarded. Each
+// attempt has its own timeout; idempotent calls (GET, DELETE) are retried on
+// transport errors and 429/502/503/504 with exponential backoff. Uploads are
+// streamed and never retried.
+package filesapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// Defaults used when Config fields are zero
+const (
+	DefaultTimeout        = 10 * time.Second
+	DefaultUploadTimeout  = 2 * time.Minute
+	DefaultRetryBaseDelay = 200 * time.Millisecond
+	maxRetryDelay         = 5 * time.Second
+	maxErrorBody          = 512
+)
+
+// ErrNotFound is returned when files-api has no file with the requested ID
+var ErrNotFound = errors.New("file not found in files-api")
+
+type tokenKey struct{}
+
+// WithToken returns a context whose requests to files-api are authenticated
+// with the given access token (usually the caller's own token)
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+// TokenFromContext returns the token set by WithToken, or ""
+func TokenFromContext(ctx context.Context) string {
+	token, _ := ctx.Value(tokenKey{}).(string)
+	return token
+}
+
+// StatusError is returned when files-api answers with an unexpected status
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	if e.Body == "" {
+		return fmt.Sprintf("files-api returned status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("files-api returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// Config configures the client
+type Config struct {
+	// BaseURL is the files-api API root (FILES_API_URL)
+	BaseURL string
+	// HealthURL is probed by the health checker. Defaults to /health on the BaseURL host.
+	HealthURL string
+	// Timeout bounds a single attempt
+	Timeout time.Duration
+	// UploadTimeout bounds a streamed upload
+	UploadTimeout time.Duration
+	// MaxRetries is how many times a failed idempotent call is retried
+	MaxRetries int
+	// RetryBaseDelay is the first backoff delay; it doubles per retry
+	RetryBaseDelay time.Duration
+	// HTTPClient sends the requests. Defaults to a new client without a global timeout.
+	HTTPClient *http.Client
+}
+
+// Upload is a file streamed to files-api
+type Upload struct {
+	FileName    string
+	ContentType string
+	// FileType is the files-api category the file is stored under (image, document)
+	FileType string
+	Body     io.Reader
+}
+
+// PresignRequest describes a file the caller is about to upload
+type PresignRequest struct {
+	FileName    string `json:"fileName"`
+	ContentType string `json:"contentType"`
+	FileType    string `json:"fileType"`
+	FileSize    int64  `json:"fileSize,omitempty"`
+}
+
+// PresignedUpload is a short-lived URL the caller uploads the file to directly
+type PresignedUpload struct {
+	FileID    int64             `json:"fileId"`
+	URL       string            `json:"url"`
+	Method    string            `json:"method"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+}
+
+// Client calls files-api
+type Client struct {
+	cfg        Config
+	httpClient *http.Client
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+// NewClient creates a client
+func NewClient(cfg Config) *Client {
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if cfg.HealthURL == "" {
+		cfg.HealthURL = defaultHealthURL(cfg.BaseURL)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+	if cfg.UploadTimeout <= 0 {
+		cfg.UploadTimeout = DefaultUploadTimeout
+	}
+	if cfg.MaxRetries < 0 {
+		cfg.MaxRetries = 0
+	}
+	if cfg.RetryBaseDelay <= 0 {
+		cfg.RetryBaseDelay = DefaultRetryBaseDelay
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	return &Client{cfg: cfg, httpClient: httpClient, sleep: sleepContext}
+}
+
+// GetFile returns the metadata of a file. Missing files return ErrNotFound.
+func (c *Client) GetFile(ctx context.Context, id int64) (*models.StorageFile, error) {
+	var file models.StorageFile
+	status, err := c.do(ctx, http.MethodGet, c.fileURL(id), nil, &file)
+	if status == http.StatusNotFound {
+		return nil, fmt.Errorf("failed to get file %d: %w", id, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get file %d: %w", id, err)
+	}
+	return &file, nil
+}
+
+// DeleteFile deletes a file object and its storage.files row. A file that is
+// already gone counts as deleted.
+func (c *Client) DeleteFile(ctx context.Context, id int64) error {
+	status, err := c.do(ctx, http.MethodDelete, c.fileURL(id), nil, nil)
+	if status == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete file %d: %w", id, err)
+	}
+	return nil
+}
+
+// PresignUpload asks files-api for an upload URL. It is not retried because
+// files-api reserves a file record per call.
+func (c *Client) PresignUpload(ctx context.Context, req PresignRequest) (*PresignedUpload, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode presign request: %w", err)
+	}
+	var upload PresignedUpload
+	if _, err := c.do(ctx, http.MethodPost, c.cfg.BaseURL+"/files/presign", body, &upload); err != nil {
+		return nil, fmt.Errorf("failed to presign upload of %s: %w", req.FileName, err)
+	}
+	return &upload, nil
+}
+
+// Upload streams a file to files-api as multipart/form-data and returns the
+// stored file. The body is read once, so failed uploads are not retried.
+// Upload returns only after it has stopped reading upload.Body.
+func (c *Client) Upload(ctx context.Context, upload Upload) (*models.StorageFile, error) {
+	pr, pw := io.Pipe()
+	form := multipart.NewWriter(pw)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = pw.CloseWithError(writeUploadForm(form, upload))
+	}()
+
+	var file models.StorageFile
+	_, err := c.attempt(ctx, http.MethodPost, c.cfg.BaseURL+"/files", pr, form.FormDataContentType(), c.cfg.UploadTimeout, &file)
+	// Unblock the writer if the request ended before the body was consumed
+	_ = pr.Close()
+	<-done
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload %s: %w", upload.FileName, err)
+	}
+	return &file, nil
+}
+
+func writeUploadForm(form *multipart.Writer, upload Upload) error {
+	if err := form.WriteField("fileType", upload.FileType); err != nil {
+		return err
+	}
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", mime.FormatMediaType("form-data", map[string]string{
+		"name":     "file",
+		"filename": upload.FileName,
+	}))
+	if upload.ContentType != "" {
+		header.Set("Content-Type", upload.ContentType)
+	}
+	part, err := form.CreatePart(header)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, upload.Body); err != nil {
+		return err
+	}
+	return form.Close()
+}
+
+// Ping checks that files-api answers its health endpoint
+func (c *Client) Ping(ctx context.Context) error {
+	if _, err := c.do(ctx, http.MethodGet, c.cfg.HealthURL, nil, nil); err != nil {
+		return fmt.Errorf("failed to reach files-api: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) fileURL(id int64) string {
+	return c.cfg.BaseURL + "/files/" + strconv.FormatInt(id, 10)
+}
+
+// do sends a request, retrying idempotent methods, and decodes a 2xx JSON
+// response into out when it is non-nil. It returns the last status code seen
+// (0 when no response was received).
+func (c *Client) do(ctx context.Context, method, target string, body []byte, out interface{}) (int, error) {
+	retries := 0
+	if method == http.MethodGet || method == http.MethodDelete {
+		retries = c.cfg.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		var reader io.Reader
+		contentType := ""
+		if body != nil {
+			reader, contentType = bytes.NewReader(body), "application/json"
+		}
+		status, err := c.attempt(ctx, method, target, reader, contentType, c.cfg.Timeout, out)
+		if err == nil || attempt >= retries || !retryable(status, err) || ctx.Err() != nil {
+			return status, err
+		}
+		delay := c.cfg.RetryBaseDelay << attempt
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+		if sleepErr := c.sleep(ctx, delay); sleepErr != nil {
+			return status, err
+		}
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, method, target string, body io.Reader, contentType string, timeout time.Duration, out interface{}) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if token := TokenFromContext(ctx); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode/100 != 2 {
+		errBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return resp.StatusCode, &StatusError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(errBody))}
+	}
+	if out == nil {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return resp.StatusCode, nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+// retryable reports whether a failed attempt may succeed when repeated
+func retryable(status int, err error) bool {
+	switch status {
+	case 0:
+		// Transport error or per-attempt timeout
+		return err != nil
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// defaultHealthURL maps http://host:8085/api/v1 to http://host:8085/health
+func defaultHealthURL(baseURL string) string {
+	u, err := url.Parse(baseURL)
+	if err != nil || u.Host == "" {
+		return baseURL + "/health"
+	}
+	u.Path, u.RawQuery, u.Fragment = "/health", "", ""
+	return u.String()
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,175 @@
+package filesapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/GunarsK-portfolio/portfolio-common/health"
+)
+
+func newTestClient(t *testing.T, srv *httptest.Server, maxRetries int) *Client {
+	t.Helper()
+	client := NewClient(Config{
+		BaseURL:    srv.URL + "/api/v1/",
+		Timeout:    time.Second,
+		MaxRetries: maxRetries,
+		HTTPClient: srv.Client(),
+	})
+	client.sleep = func(ctx context.Context, d time.Duration) error { return ctx.Err() }
+	return client
+}
+
+func TestDeleteFile(t *testing.T) {
+	var gotAuth, gotPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth, gotPath = r.Header.Get("Authorization"), r.Method+" "+r.URL.Path
+		switch r.URL.Path {
+		case "/api/v1/files/1":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v1/files/2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			http.Error(w, "forbidden", http.StatusForbidden)
+		}
+	}))
+	defer srv.Close()
+
+	client := newTestClient(t, srv, 0)
+	ctx := WithToken(context.Background(), "token-123")
+
+	if err := client.DeleteFile(ctx, 1); err != nil {
+		t.Fatalf("DeleteFile(1) error = %v", err)
+	}
+	if gotPath != "DELETE /api/v1/files/1" || gotAuth != "Bearer token-123" {
+		t.Errorf("request = %q with auth %q, want DELETE /api/v1/files/1 with the caller's token", gotPath, gotAuth)
+	}
+
+	if err := client.DeleteFile(ctx, 2); err != nil {
+		t.Errorf("DeleteFile(already gone) error = %v, want nil", err)
+	}
+
+	var statusErr *StatusError
+	if err := client.DeleteFile(ctx, 3); !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusForbidden {
+		t.Errorf("DeleteFile(3) error = %v, want StatusError 403", err)
+	}
+}
+
+func TestGetFile_RetriesTransientErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/files/404":
+			w.WriteHeader(http.StatusNotFound)
+		case calls.Add(1) < 3:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": 5, "mimeType": "image/png"})
+		}
+	}))
+	defer srv.Close()
+
+	client := newTestClient(t, srv, 2)
+	file, err := client.GetFile(context.Background(), 5)
+	if err != nil {
+		t.Fatalf("GetFile() error = %v", err)
+	}
+	if file.ID != 5 || file.MimeType != "image/png" || calls.Load() != 3 {
+		t.Errorf("GetFile() = %+v after %d calls, want file 5 after 3", file, calls.Load())
+	}
+
+	if _, err := client.GetFile(context.Background(), 404); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetFile(missing) error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestPresignUpload_NotRetried(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var req PresignRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.FileName != "a.png" {
+			t.Errorf("presign body = %+v, %v", req, err)
+		}
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	client := newTestClient(t, srv, 3)
+	_, err := client.PresignUpload(context.Background(), PresignRequest{FileName: "a.png", ContentType: "image/png", FileType: "image"})
+
+	var statusErr *StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("PresignUpload() error = %v, want StatusError 502", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("files-api called %d times, want 1 (POST is not retried)", calls.Load())
+	}
+}
+
+func TestUpload_StreamsMultipartForm(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/files" {
+			t.Errorf("request = %s %s, want POST /api/v1/files", r.Method, r.URL.Path)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile() error = %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		content, _ := io.ReadAll(file)
+		if r.FormValue("fileType") != "image" || header.Filename != "front.png" || string(content) != "png-bytes" {
+			t.Errorf("form = %q %q %q, want image front.png png-bytes", r.FormValue("fileType"), header.Filename, content)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"id": 11, "mimeType": "image/png", "fileType": "image"})
+	}))
+	defer srv.Close()
+
+	client := newTestClient(t, srv, 0)
+	file, err := client.Upload(context.Background(), Upload{
+		FileName:    "front.png",
+		ContentType: "image/png",
+		FileType:    "image",
+		Body:        strings.NewReader("png-bytes"),
+	})
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if file.ID != 11 || file.MimeType != "image/png" {
+		t.Errorf("Upload() = %+v, want file 11", file)
+	}
+}
+
+func TestHealthChecker(t *testing.T) {
+	healthy := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			t.Errorf("health probe path = %s, want /health", r.URL.Path)
+		}
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	checker := NewHealthChecker(newTestClient(t, srv, 0))
+	if checker.Name() != "files-api" {
+		t.Errorf("Name() = %q, want files-api", checker.Name())
+	}
+	if result := checker.Check(context.Background()); result.Status != health.StatusHealthy {
+		t.Errorf("Check() = %+v, want healthy", result)
+	}
+
+	healthy = false
+	if result := checker.Check(context.Background()); result.Status != health.StatusDegraded || result.Error == "" {
+		t.Errorf("Check() = %+v, want degraded with an error", result)
+	}
+}
@@ -0,0 +1,40 @@
+package filesapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/GunarsK-portfolio/portfolio-common/health"
+)
+
+// HealthChecker reports files-api availability. An outage only affects file
+// uploads, links and purges, so it is reported as degraded, not unhealthy.
+type HealthChecker struct {
+	client *Client
+}
+
+// NewHealthChecker creates a checker for the health.Aggregator
+func NewHealthChecker(client *Client) health.Checker {
+	return &HealthChecker{client: client}
+}
+
+// Name returns the name of this checker
+func (c *HealthChecker) Name() string {
+	return "files-api"
+}
+
+// Check probes the files-api health endpoint
+func (c *HealthChecker) Check(ctx context.Context) health.CheckResult {
+	start := time.Now()
+	if err := c.client.Ping(ctx); err != nil {
+		return health.CheckResult{
+			Status:  health.StatusDegraded,
+			Latency: time.Since(start).String(),
+			Error:   err.Error(),
+		}
+	}
+	return health.CheckResult{
+		Status:  health.StatusHealthy,
+		Latency: time.Since(start).String(),
+	}
+}
@@ -0,0 +1,279 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/repository"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// articleRequest is the request body for create/update article
+type articleRequest struct {
+	models.Article
+	SkillIDs []int64 `json:"skillIds,omitempty"`
+}
+
+func validateArticleRequest(req *articleRequest) validation.Errors {
+	return validation.Article(&req.Article)
+}
+
+// GetAllArticles godoc
+// @Summary Get all articles
+// @Description Get all blog articles, newest publication first; drafts without a publication date come first
+// @Tags Blog - Articles
+// @Produce json
+// @Security BearerAuth
+// @Param tag query string false "Only records with this tag (case-insensitive)"
+// @Param status query string false "Only articles with this status" Enums(draft, published, archived)
+// @Success 200 {array} models.Article
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /blog/articles [get]
+func (h *Handler) GetAllArticles(c *gin.Context) {
+	filter := models.ArticleFilter{ListFilter: listFilter(c), Status: c.Query("status")}
+	switch filter.Status {
+	case "", models.ArticleStatusDraft, models.ArticleStatusPublished, models.ArticleStatusArchived:
+	default:
+		problem.RespondError(c, http.StatusBadRequest, "status must be draft, published or archived")
+		return
+	}
+
+	articles, err := h.repo.GetAllArticles(c.Request.Context(), filter)
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch articles")
+		return
+	}
+
+	c.JSON(http.StatusOK, articles)
+}
+
+// GetArticleByID godoc
+// @Summary Get article by ID
+// @Description Get a single blog article by ID
+// @Tags Blog - Articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Success 200 {object} models.Article
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id} [get]
+func (h *Handler) GetArticleByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	article, err := h.repo.GetArticleByID(c.Request.Context(), id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "article not found", "failed to fetch article")
+		return
+	}
+
+	c.JSON(http.StatusOK, article)
+}
+
+// CreateArticle godoc
+// @Summary Create article
+// @Description Create a new blog article with optional skills. body is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.
+// @Description status defaults to draft. A published article without publishedAt is published now. The slug is derived from the title.
+// @Tags Blog - Articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param article body articleRequest true "Article data with optional skillIds"
+// @Success 201 {object} models.Article
+// @Header 201 {string} Location "URL of the created resource"
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles [post]
+func (h *Handler) CreateArticle(c *gin.Context) {
+	var req articleRequest
+	if !bindValid(c, &req, validateArticleRequest) {
+		return
+	}
+	if !h.validFileRefs(c, fileRef{"coverFileId", filerefs.UsageArticleCover, req.CoverFileID}) {
+		return
+	}
+	prepareArticle(&req.Article)
+
+	ctx := c.Request.Context()
+	if err := h.repo.CreateArticle(ctx, &req.Article, req.SkillIDs); err != nil {
+		respondArticleError(c, err, "", "failed to create article")
+		return
+	}
+
+	// Reload article with skills, slug and cover URL
+	article, err := h.repo.GetArticleByID(ctx, req.ID)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "article not found", "failed to fetch created article")
+		return
+	}
+
+	setLocationHeader(c, article.ID)
+	h.emit(c, models.EntityArticle, events.ActionCreated, article.ID)
+	c.JSON(http.StatusCreated, article)
+}
+
+// UpdateArticle godoc
+// @Summary Update article
+// @Description Update an existing blog article and replace its skills (an empty or missing skillIds removes them). body is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.
+// @Tags Blog - Articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Param article body articleRequest true "Article data with optional skillIds"
+// @Success 200 {object} models.Article
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id} [put]
+func (h *Handler) UpdateArticle(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req articleRequest
+	if !bindValid(c, &req, validateArticleRequest) {
+		return
+	}
+	if !h.validFileRefs(c, fileRef{"coverFileId", filerefs.UsageArticleCover, req.CoverFileID}) {
+		return
+	}
+	prepareArticle(&req.Article)
+
+	ctx := c.Request.Context()
+	req.ID = id
+	if err := h.repo.UpdateArticle(ctx, &req.Article, req.SkillIDs); err != nil {
+		respondArticleError(c, err, "article not found", "failed to update article")
+		return
+	}
+
+	// Reload article with skills, slug and cover URL
+	article, err := h.repo.GetArticleByID(ctx, id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "article not found", "failed to fetch updated article")
+		return
+	}
+
+	h.emit(c, models.EntityArticle, events.ActionUpdated, id)
+	c.JSON(http.StatusOK, article)
+}
+
+// DeleteArticle godoc
+// @Summary Delete article
+// @Description Delete a blog article with its skill links, tags, slugs and SEO metadata
+// @Tags Blog - Articles
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Success 204
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id} [delete]
+func (h *Handler) DeleteArticle(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.repo.DeleteArticle(c.Request.Context(), id); err != nil {
+		respondDeleteError(c, err, "article not found", "failed to delete article")
+		return
+	}
+
+	h.emit(c, models.EntityArticle, events.ActionDeleted, id)
+	c.Status(http.StatusNoContent)
+}
+
+// SetArticleSkills godoc
+// @Summary Set skills for an article
+// @Description Replace all skills of an article with the provided list; an empty list removes them
+// @Tags Blog - Articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Param skills body object{skillIds=[]int64} true "List of skill IDs"
+// @Success 200 {object} models.Article
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id}/skills [put]
+func (h *Handler) SetArticleSkills(c *gin.Context) {
+	articleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid article id")
+		return
+	}
+
+	var req struct {
+		SkillIDs []int64 `json:"skillIds"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.RespondBindingError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.repo.SetArticleSkills(ctx, articleID, req.SkillIDs); err != nil {
+		respondArticleError(c, err, "article not found", "failed to set skills")
+		return
+	}
+
+	h.emit(c, models.EntityArticle, events.ActionUpdated, articleID)
+
+	// Return updated article
+	article, err := h.repo.GetArticleByID(ctx, articleID)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "article not found", "failed to fetch article")
+		return
+	}
+	c.JSON(http.StatusOK, article)
+}
+
+// prepareArticle defaults the status to draft and dates a published article
+// without a publication date to now. Read-only fields from the request are
+// dropped.
+func prepareArticle(article *models.Article) {
+	if article.Status == "" {
+		article.Status = models.ArticleStatusDraft
+	}
+	if article.Status == models.ArticleStatusPublished && article.PublishedAt == nil {
+		now := time.Now().UTC()
+		article.PublishedAt = &now
+	}
+	article.Slug, article.Tags = "", nil
+	article.CoverFile, article.Skills = nil, nil
+}
+
+// respondArticleError maps unknown skill IDs to 422 and everything else as a
+// repository error
+func respondArticleError(c *gin.Context, err error, notFoundDetail, internalDetail string) {
+	if errors.Is(err, repository.ErrUnknownSkills) {
+		problem.RespondFieldErrors(c, http.StatusUnprocessableEntity, err.Error(),
+			problem.FieldError{Field: "skillIds", Message: "must be existing skill IDs"})
+		return
+	}
+	problem.HandleRepositoryError(c, err, notFoundDetail, internalDetail)
+}
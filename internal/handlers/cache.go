@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/cache"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+// PurgeCache godoc
+// @Summary Purge public API cache
+// @Description Invalidate public API cache keys in Redis. entities purges every key mapped to those
+// @Description entity types (per-ID keys as * patterns); keys purges explicit keys or patterns.
+// @Description Keys must start with portfolio:, miniatures: or blog: and must not start with a wildcard
+// @Description after it. An empty body purges every mapped key.
+// @Tags Cache
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.CachePurgeRequest false "Entities and keys to purge"
+// @Success 200 {object} models.CachePurgeResponse
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Failure 503 {object} problem.Problem
+// @Router /cache/purge [post]
+func (h *Handler) PurgeCache(c *gin.Context) {
+	var req models.CachePurgeRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		problem.RespondBindingError(c, err)
+		return
+	}
+
+	if h.cachePurger == nil {
+		problem.RespondError(c, http.StatusServiceUnavailable, "cache invalidation is not enabled")
+		return
+	}
+
+	purged, err := h.cachePurger.Purge(c.Request.Context(), req.Entities, req.Keys)
+	if err != nil {
+		if errors.Is(err, cache.ErrUnknownEntity) || errors.Is(err, cache.ErrInvalidKey) {
+			problem.RespondError(c, http.StatusBadRequest, err.Error())
+			return
+		}
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to purge cache")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.CachePurgeResponse{Purged: purged})
+}
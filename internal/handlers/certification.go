@@ -3,31 +3,72 @@ package handlers
 import (
 	"net/http"
 	"strconv"
+	"time"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/certexpiry"
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
 // GetAllCertifications godoc
 // @Summary Get all certifications
-// @Description Get all certification entries
+// @Description Get all certification entries with computed expiry status (active, expiring or expired)
+// @Description expiring=90d returns certifications expiring within 90 days that have not expired yet
+// @Description expired=true returns only expired certifications, expired=false only non-expired ones
 // @Tags Portfolio - Certifications
 // @Produce json
 // @Security BearerAuth
-// @Success 200 {array} models.Certification
+// @Param expiring query string false "Only certifications expiring within the given days (e.g. 90d)"
+// @Param expired query bool false "Filter by expired state"
+// @Success 200 {array} models.CertificationResponse
+// @Failure 400 {object} problem.Problem
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /portfolio/certifications [get]
 func (h *Handler) GetAllCertifications(c *gin.Context) {
+	expiringWithin := -1
+	if raw := c.Query("expiring"); raw != "" {
+		days, err := certexpiry.ParseDays(raw)
+		if err != nil {
+			problem.RespondError(c, http.StatusBadRequest, "invalid expiring: "+err.Error())
+			return
+		}
+		expiringWithin = days
+	}
+
+	var expired *bool
+	if raw := c.Query("expired"); raw != "" {
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			problem.RespondError(c, http.StatusBadRequest, "expired must be true or false")
+			return
+		}
+		expired = &parsed
+	}
+
 	certs, err := h.repo.GetAllCertifications(c.Request.Context())
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch certifications")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch certifications")
 		return
 	}
 
-	c.JSON(http.StatusOK, certs)
+	now := time.Now()
+	result := make([]models.CertificationResponse, 0, len(certs))
+	for _, cert := range certs {
+		resp := h.certificationResponse(cert, now)
+		if expired != nil && *expired != (resp.Status == certexpiry.StatusExpired) {
+			continue
+		}
+		if expiringWithin >= 0 && (resp.DaysUntilExpiry == nil || *resp.DaysUntilExpiry < 0 || *resp.DaysUntilExpiry > expiringWithin) {
+			continue
+		}
+		result = append(result, resp)
+	}
+
+	c.JSON(http.StatusOK, result)
 }
 
 // GetCertificationByID godoc
@@ -37,26 +78,26 @@ func (h *Handler) GetAllCertifications(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Certification ID"
-// @Success 200 {object} models.Certification
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Success 200 {object} models.CertificationResponse
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/certifications/{id} [get]
 func (h *Handler) GetCertificationByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	cert, err := h.repo.GetCertificationByID(c.Request.Context(), id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "certification not found", "failed to fetch certification")
+		problem.HandleRepositoryError(c, err, "certification not found", "failed to fetch certification")
 		return
 	}
 
-	c.JSON(http.StatusOK, cert)
+	c.JSON(http.StatusOK, h.certificationResponse(*cert, time.Now()))
 }
 
 // CreateCertification godoc
@@ -67,26 +108,26 @@ func (h *Handler) GetCertificationByID(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param certification body models.Certification true "Certification data"
-// @Success 201 {object} models.Certification
+// @Success 201 {object} models.CertificationResponse
 // @Header 201 {string} Location "URL of the created resource"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/certifications [post]
 func (h *Handler) CreateCertification(c *gin.Context) {
 	var cert models.Certification
-	if err := c.ShouldBindJSON(&cert); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &cert, validation.Certification) {
 		return
 	}
 
 	if err := h.repo.CreateCertification(c.Request.Context(), &cert); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "", "failed to create certification")
+		problem.HandleRepositoryError(c, err, "", "failed to create certification")
 		return
 	}
 
 	setLocationHeader(c, cert.ID)
-	c.JSON(http.StatusCreated, cert)
+	h.emit(c, models.EntityCertification, events.ActionCreated, cert.ID)
+	c.JSON(http.StatusCreated, h.certificationResponse(cert, time.Now()))
 }
 
 // UpdateCertification godoc
@@ -98,31 +139,31 @@ func (h *Handler) CreateCertification(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path int true "Certification ID"
 // @Param certification body models.Certification true "Certification data"
-// @Success 200 {object} models.Certification
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Success 200 {object} models.CertificationResponse
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/certifications/{id} [put]
 func (h *Handler) UpdateCertification(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var cert models.Certification
-	if err := c.ShouldBindJSON(&cert); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &cert, validation.Certification) {
 		return
 	}
 
 	cert.ID = id
 	if err := h.repo.UpdateCertification(c.Request.Context(), &cert); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "certification not found", "failed to update certification")
+		problem.HandleRepositoryError(c, err, "certification not found", "failed to update certification")
 		return
 	}
 
-	c.JSON(http.StatusOK, cert)
+	h.emit(c, models.EntityCertification, events.ActionUpdated, id)
+	c.JSON(http.StatusOK, h.certificationResponse(cert, time.Now()))
 }
 
 // DeleteCertification godoc
@@ -132,21 +173,35 @@ func (h *Handler) UpdateCertification(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path int true "Certification ID"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/certifications/{id} [delete]
 func (h *Handler) DeleteCertification(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	if err := h.repo.DeleteCertification(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "certification not found", "failed to delete certification")
+		respondDeleteError(c, err, "certification not found", "failed to delete certification")
 		return
 	}
 
+	h.emit(c, models.EntityCertification, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
+
+// certificationResponse adds the computed expiry status to a certification
+func (h *Handler) certificationResponse(cert models.Certification, now time.Time) models.CertificationResponse {
+	resp := models.CertificationResponse{
+		Certification: cert,
+		Status:        certexpiry.Status(cert.ExpiryDate, now, h.certExpiringWindowDays),
+	}
+	if days, ok := certexpiry.DaysUntilExpiry(cert.ExpiryDate, now); ok {
+		resp.DaysUntilExpiry = &days
+	}
+	return resp
+}
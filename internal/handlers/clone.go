@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+// cloneOptions reads ?includeImages=true. It responds 400 and reports false
+// when the value is not a boolean.
+func cloneOptions(c *gin.Context) (models.CloneOptions, bool) {
+	var opts models.CloneOptions
+	if raw := c.Query("includeImages"); raw != "" {
+		include, err := strconv.ParseBool(raw)
+		if err != nil {
+			problem.RespondFieldErrors(c, http.StatusBadRequest, "invalid clone options",
+				problem.FieldError{Field: "includeImages", Message: "must be true or false"})
+			return opts, false
+		}
+		opts.IncludeImages = include
+	}
+	return opts, true
+}
+
+// setCloneLocationHeader points Location at the copy, a sibling of the
+// record in .../:id/clone
+func setCloneLocationHeader(c *gin.Context, id int64) {
+	collection := path.Dir(path.Dir(c.Request.URL.Path))
+	c.Header("Location", path.Join(collection, strconv.FormatInt(id, 10)))
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultExpiringWithinDays = 30
+	maxExpiringWithinDays     = 3650
+)
+
+// GetDashboard godoc
+// @Summary Get content dashboard
+// @Description Get record counts per entity and a content quality report: projects without image,
+// @Description projects without githubUrl and liveUrl, work experience without description,
+// @Description skills not used by any project, certifications expiring soon, miniature projects
+// @Description without images and themes without a cover
+// @Description Only entities the caller has read permission on are included
+// @Tags Dashboard
+// @Produce json
+// @Security BearerAuth
+// @Param expiringWithinDays query int false "Report certifications expiring within N days (default 30)"
+// @Success 200 {object} models.Dashboard
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /dashboard [get]
+func (h *Handler) GetDashboard(c *gin.Context) {
+	days := defaultExpiringWithinDays
+	if raw := c.Query("expiringWithinDays"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 0 || parsed > maxExpiringWithinDays {
+			problem.RespondError(c, http.StatusBadRequest, "expiringWithinDays must be between 0 and 3650")
+			return
+		}
+		days = parsed
+	}
+
+	dashboard, err := h.repo.GetDashboard(c.Request.Context(), days)
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch dashboard")
+		return
+	}
+
+	// Only expose entities the caller is allowed to read
+	result := models.Dashboard{
+		Counts:        []models.EntityCount{},
+		Findings:      []models.ContentFinding{},
+		FindingCounts: map[string]int{},
+	}
+	for _, count := range dashboard.Counts {
+		if canRead(c, count.Entity) {
+			result.Counts = append(result.Counts, count)
+		}
+	}
+	for _, finding := range dashboard.Findings {
+		if !canRead(c, finding.Entity) {
+			continue
+		}
+		finding.Path = entityPath(finding.Entity, finding.EntityID)
+		result.Findings = append(result.Findings, finding)
+		result.FindingCounts[finding.Check]++
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// dependentsProblem is the 409 response for a delete blocked by records that
+// still reference the entity
+type dependentsProblem struct {
+	problem.Problem
+	Dependents []models.Dependent `json:"dependents"`
+}
+
+// deleteOptions reads ?cascade=true and ?reassignTo=ID. It responds 400 and
+// reports false when they are malformed or both set.
+func deleteOptions(c *gin.Context) (models.DeleteOptions, bool) {
+	var opts models.DeleteOptions
+	var fields []problem.FieldError
+
+	if raw := c.Query("cascade"); raw != "" {
+		cascade, err := strconv.ParseBool(raw)
+		if err != nil {
+			fields = append(fields, problem.FieldError{Field: "cascade", Message: "must be true or false"})
+		}
+		opts.Cascade = cascade
+	}
+	if raw := c.Query("reassignTo"); raw != "" {
+		target, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || target < 1 {
+			fields = append(fields, problem.FieldError{Field: "reassignTo", Message: "must be a positive ID"})
+		}
+		opts.ReassignTo = &target
+	}
+	if len(fields) == 0 && opts.Cascade && opts.ReassignTo != nil {
+		fields = append(fields, problem.FieldError{Field: "reassignTo", Message: "cannot be combined with cascade"})
+	}
+
+	if len(fields) > 0 {
+		problem.RespondFieldErrors(c, http.StatusBadRequest, "invalid delete options", fields...)
+		return opts, false
+	}
+	return opts, true
+}
+
+// respondDeleteError maps errors from deletes: blocking dependents are a 409
+// listing them, a bad reassign target is a 422, anything else is handled as a
+// repository error
+func respondDeleteError(c *gin.Context, err error, notFoundDetail, internalDetail string) {
+	var depsErr *repository.DependentsError
+	if errors.As(err, &depsErr) {
+		p := problem.New(c, http.StatusConflict, depsErr.Error())
+		p.Type = problem.TypeHasDependents
+		dependents := depsErr.Dependents
+		if dependents == nil {
+			dependents = []models.Dependent{}
+		}
+		problem.WriteBody(c, p.Status, dependentsProblem{Problem: *p, Dependents: dependents})
+		return
+	}
+	if errors.Is(err, repository.ErrInvalidReassignTarget) {
+		problem.RespondFieldErrors(c, http.StatusUnprocessableEntity, err.Error(),
+			problem.FieldError{Field: "reassignTo", Message: "must be another existing record of the same kind"})
+		return
+	}
+	problem.HandleRepositoryError(c, err, notFoundDetail, internalDetail)
+}
@@ -0,0 +1,155 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// GetAllEducation godoc
+// @Summary Get all education
+// @Description Get all education entries ordered by display order, then most recent first
+// @Tags Portfolio - Education
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.Education
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /portfolio/education [get]
+func (h *Handler) GetAllEducation(c *gin.Context) {
+	education, err := h.repo.GetAllEducation(c.Request.Context())
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch education")
+		return
+	}
+
+	c.JSON(http.StatusOK, education)
+}
+
+// GetEducationByID godoc
+// @Summary Get education by ID
+// @Description Get a single education entry by ID
+// @Tags Portfolio - Education
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Education ID"
+// @Success 200 {object} models.Education
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/education/{id} [get]
+func (h *Handler) GetEducationByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	education, err := h.repo.GetEducationByID(c.Request.Context(), id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "education not found", "failed to fetch education")
+		return
+	}
+
+	c.JSON(http.StatusOK, education)
+}
+
+// CreateEducation godoc
+// @Summary Create education
+// @Description Create a new education entry. Leave endDate empty for studies in progress.
+// @Tags Portfolio - Education
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param education body models.Education true "Education data"
+// @Success 201 {object} models.Education
+// @Header 201 {string} Location "URL of the created resource"
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/education [post]
+func (h *Handler) CreateEducation(c *gin.Context) {
+	var education models.Education
+	if !bindValid(c, &education, validation.Education) {
+		return
+	}
+
+	if err := h.repo.CreateEducation(c.Request.Context(), &education); err != nil {
+		problem.HandleRepositoryError(c, err, "", "failed to create education")
+		return
+	}
+
+	setLocationHeader(c, education.ID)
+	h.emit(c, models.EntityEducation, events.ActionCreated, education.ID)
+	c.JSON(http.StatusCreated, education)
+}
+
+// UpdateEducation godoc
+// @Summary Update education
+// @Description Update an existing education entry
+// @Tags Portfolio - Education
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Education ID"
+// @Param education body models.Education true "Education data"
+// @Success 200 {object} models.Education
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/education/{id} [put]
+func (h *Handler) UpdateEducation(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var education models.Education
+	if !bindValid(c, &education, validation.Education) {
+		return
+	}
+
+	education.ID = id
+	if err := h.repo.UpdateEducation(c.Request.Context(), &education); err != nil {
+		problem.HandleRepositoryError(c, err, "education not found", "failed to update education")
+		return
+	}
+
+	h.emit(c, models.EntityEducation, events.ActionUpdated, id)
+	c.JSON(http.StatusOK, education)
+}
+
+// DeleteEducation godoc
+// @Summary Delete education
+// @Description Delete an education entry
+// @Tags Portfolio - Education
+// @Security BearerAuth
+// @Param id path int true "Education ID"
+// @Success 204
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/education/{id} [delete]
+func (h *Handler) DeleteEducation(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.repo.DeleteEducation(c.Request.Context(), id); err != nil {
+		respondDeleteError(c, err, "education not found", "failed to delete education")
+		return
+	}
+
+	h.emit(c, models.EntityEducation, events.ActionDeleted, id)
+	c.Status(http.StatusNoContent)
+}
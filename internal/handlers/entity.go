@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	common "github.com/GunarsK-portfolio/portfolio-common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// entityRoute maps an entity type to the permission resources guarding it
+// (access to any of them is enough) and the admin API path its records are
+// served under
+type entityRoute struct {
+	resources []string
+	path      string
+}
+
+var entityRoutes = map[string]entityRoute{
+	models.EntityProfile:            {[]string{common.ResourceProfile}, "/portfolio/profile"},
+	models.EntityWorkExperience:     {[]string{common.ResourceExperience}, "/portfolio/experience"},
+	models.EntityCertification:      {[]string{common.ResourceCertifications}, "/portfolio/certifications"},
+	models.EntityEducation:          {[]string{models.ResourceEducation}, "/portfolio/education"},
+	models.EntityTestimonial:        {[]string{models.ResourceTestimonials}, "/portfolio/testimonials"},
+	models.EntitySkill:              {[]string{common.ResourceSkills}, "/portfolio/skills"},
+	models.EntitySkillType:          {[]string{common.ResourceSkills}, "/portfolio/skill-types"},
+	models.EntityPortfolioProject:   {[]string{common.ResourceProjects}, "/portfolio/projects"},
+	models.EntityMiniatureTheme:     {[]string{common.ResourceMiniatures}, "/miniatures/themes"},
+	models.EntityMiniatureProject:   {[]string{common.ResourceMiniatures}, "/miniatures/projects"},
+	models.EntityMiniaturePaint:     {[]string{common.ResourceMiniatures}, "/miniatures/paints"},
+	models.EntityMiniatureTechnique: {[]string{common.ResourceMiniatures}, "/miniatures/techniques"},
+	models.EntityFile:               {[]string{common.ResourceFiles}, "/files"},
+	models.EntityTag:                {models.TagResources, "/tags"},
+	models.EntityArticle:            {[]string{models.ResourceBlog}, "/blog/articles"},
+}
+
+// entityPath returns the admin API path of a single record, e.g. /portfolio/projects/12.
+// Singleton entities (profile) are served without an ID segment.
+func entityPath(entity string, id int64) string {
+	route, ok := entityRoutes[entity]
+	if !ok {
+		return ""
+	}
+	if entity == models.EntityProfile {
+		return route.path
+	}
+	return route.path + "/" + strconv.FormatInt(id, 10)
+}
+
+// canRead reports whether the caller's JWT scopes grant read access to the entity type
+func canRead(c *gin.Context, entity string) bool {
+	route, ok := entityRoutes[entity]
+	if !ok {
+		return false
+	}
+	scopes := c.GetStringMapString(common.CtxKeyScopes)
+	for _, resource := range route.resources {
+		if common.HasPermission(scopes[resource], common.LevelRead) {
+			return true
+		}
+	}
+	return false
+}
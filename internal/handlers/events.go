@@ -0,0 +1,14 @@
+package handlers
+
+import (
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/gin-gonic/gin"
+)
+
+// emit publishes a content change event after a successful mutation
+func (h *Handler) emit(c *gin.Context, entity, action string, id int64) {
+	if h.events == nil {
+		return
+	}
+	h.events.Publish(c.Request.Context(), events.New(entity, action, id, entityPath(entity, id)))
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+// fileRef is a file ID from a request body and where it will be linked.
+// A nil id means the field was not set and is skipped.
+type fileRef struct {
+	field string
+	usage filerefs.Usage
+	id    *int64
+}
+
+// validFileRefs checks every set reference against storage.files. It responds
+// 422 with the reason for the first unusable file and reports whether all passed.
+func (h *Handler) validFileRefs(c *gin.Context, refs ...fileRef) bool {
+	for _, ref := range refs {
+		if ref.id == nil {
+			continue
+		}
+		err := h.fileRefs.Validate(c.Request.Context(), ref.field, ref.usage, *ref.id)
+		if err == nil {
+			continue
+		}
+		var refErr *filerefs.Error
+		if errors.As(err, &refErr) {
+			problem.RespondFieldErrors(c, http.StatusUnprocessableEntity, err.Error(), problem.FieldError{
+				Field:   refErr.Field,
+				Message: refErr.Reason,
+			})
+			return false
+		}
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to validate file reference")
+		return false
+	}
+	return true
+}
@@ -1,16 +1,135 @@
 package handlers
 
 import (
+	"context"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/certexpiry"
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
+	"github.com/GunarsK-portfolio/admin-api/internal/filesapi"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
 	"github.com/GunarsK-portfolio/admin-api/internal/repository"
+	"github.com/GunarsK-portfolio/admin-api/internal/stream"
+	"github.com/GunarsK-portfolio/admin-api/internal/translation"
 	commonhandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
 )
 
 type Handler struct {
-	repo repository.Repository
+	repo                   repository.Repository
+	certExpiringWindowDays int
+	events                 events.Publisher
+	webhookTester          WebhookTester
+	cachePurger            CachePurger
+	stream                 *stream.Broker
+	fileRefs               *filerefs.Validator
+	orphans                OrphanScanner
+	files                  FileStore
+	maxUploadBytes         int64
+	uploadTimeout          time.Duration
+	locales                []string
+}
+
+// WebhookTester sends a test event to a single webhook
+type WebhookTester interface {
+	SendTest(ctx context.Context, webhook *models.Webhook) (*models.WebhookDelivery, error)
+}
+
+// CachePurger invalidates public API cache keys on demand
+type CachePurger interface {
+	Purge(ctx context.Context, entities, keys []string) ([]string, error)
+}
+
+// OrphanScanner reports and purges storage files nothing links to
+type OrphanScanner interface {
+	Scan(ctx context.Context) (*models.OrphanReport, error)
+	Purge(ctx context.Context, fileIDs []int64) (*models.OrphanPurgeResult, error)
+}
+
+// FileStore stores uploaded files in files-api and deletes them again
+type FileStore interface {
+	Upload(ctx context.Context, upload filesapi.Upload) (*models.StorageFile, error)
+	DeleteFile(ctx context.Context, id int64) error
+}
+
+// Option configures optional Handler settings
+type Option func(*Handler)
+
+// WithCertExpiringWindow sets how many days before expiry a certification is reported as expiring
+func WithCertExpiringWindow(days int) Option {
+	return func(h *Handler) {
+		if days > 0 {
+			h.certExpiringWindowDays = days
+		}
+	}
+}
+
+// WithEvents sets the publisher notified about content changes
+func WithEvents(publisher events.Publisher) Option {
+	return func(h *Handler) {
+		h.events = publisher
+	}
+}
+
+// WithWebhookTester sets the sender used by the webhook test endpoint
+func WithWebhookTester(tester WebhookTester) Option {
+	return func(h *Handler) {
+		h.webhookTester = tester
+	}
+}
+
+// WithCachePurger sets the purger used by the cache purge endpoint
+func WithCachePurger(purger CachePurger) Option {
+	return func(h *Handler) {
+		h.cachePurger = purger
+	}
+}
+
+// WithEventStream sets the broker serving the live change stream
+func WithEventStream(broker *stream.Broker) Option {
+	return func(h *Handler) {
+		h.stream = broker
+	}
+}
+
+// WithOrphanScanner sets the scanner used by the orphaned files endpoints
+func WithOrphanScanner(scanner OrphanScanner) Option {
+	return func(h *Handler) {
+		h.orphans = scanner
+	}
+}
+
+// WithFileUploads enables the upload-through endpoints. maxBytes caps the
+// request size and timeout replaces the server read/write timeouts for uploads.
+func WithFileUploads(store FileStore, maxBytes int64, timeout time.Duration) Option {
+	return func(h *Handler) {
+		h.files = store
+		h.maxUploadBytes = maxBytes
+		h.uploadTimeout = timeout
+	}
+}
+
+// WithContentLocales sets the content languages. The first is the one records
+// are written in; the others can be set as translations.
+func WithContentLocales(locales []string) Option {
+	return func(h *Handler) {
+		if len(locales) > 0 {
+			h.locales = locales
+		}
+	}
 }
 
-func New(repo repository.Repository) *Handler {
-	return &Handler{repo: repo}
+func New(repo repository.Repository, opts ...Option) *Handler {
+	h := &Handler{
+		repo:                   repo,
+		certExpiringWindowDays: certexpiry.DefaultExpiringWindowDays,
+		fileRefs:               filerefs.NewValidator(repo),
+		locales:                translation.DefaultLocales,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // setLocationHeader wraps the common helper for backward compatibility
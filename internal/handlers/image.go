@@ -4,8 +4,9 @@ import (
 	"net/http"
 	"strconv"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,22 +18,24 @@ import (
 // @Security BearerAuth
 // @Param id path int true "Miniature File ID"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /files/{id} [delete]
 func (h *Handler) DeleteImage(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	if err := h.repo.DeleteImage(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "image not found", "failed to delete image")
+		respondDeleteError(c, err, "image not found", "failed to delete image")
 		return
 	}
 
+	h.emit(c, models.EntityFile, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
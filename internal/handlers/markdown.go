@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/markdown"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+// RenderMarkdown godoc
+// @Summary Render markdown
+// @Description Render markdown to the sanitized HTML the public site shows, for live previews while editing. Scripts, event handler attributes, unsafe URLs and other HTML outside the allowlist are removed and listed. Nothing is stored.
+// @Tags Markdown
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param markdown body models.MarkdownRenderRequest true "Markdown to render"
+// @Success 200 {object} models.MarkdownPreview
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /render/markdown [post]
+func (h *Handler) RenderMarkdown(c *gin.Context) {
+	var req models.MarkdownRenderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.RespondBindingError(c, err)
+		return
+	}
+
+	html, removed := markdown.Render(req.Markdown)
+	if removed == nil {
+		removed = []string{}
+	}
+	c.JSON(http.StatusOK, models.MarkdownPreview{HTML: html, Removed: removed})
+}
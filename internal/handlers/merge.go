@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/repository"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// mergeFunc folds the request's sources into the target record
+type mergeFunc func(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error)
+
+// mergeInto merges the sources in the body into the record addressed by :id.
+// Applied merges emit a deleted event per source and an updated event for the
+// target; previews change nothing.
+func (h *Handler) mergeInto(c *gin.Context, entity, notFoundDetail, internalDetail string, merge mergeFunc) {
+	targetID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req models.MergeRequest
+	if !bindValid(c, &req, validation.MergeRequest) {
+		return
+	}
+
+	result, err := merge(c.Request.Context(), targetID, req)
+	if err != nil {
+		if errors.Is(err, repository.ErrInvalidMergeSource) {
+			problem.RespondFieldErrors(c, http.StatusUnprocessableEntity, err.Error(),
+				problem.FieldError{Field: "sourceIds", Message: "must be other existing records of the same kind"})
+			return
+		}
+		respondDeleteError(c, err, notFoundDetail, internalDetail)
+		return
+	}
+
+	if !result.Preview {
+		for _, sourceID := range result.SourceIDs {
+			h.emit(c, entity, events.ActionDeleted, sourceID)
+		}
+		h.emit(c, entity, events.ActionUpdated, targetID)
+	}
+	c.JSON(http.StatusOK, result)
+}
@@ -4,26 +4,39 @@ import (
 	"net/http"
 	"strconv"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
 // GetAllMiniatureProjects godoc
 // @Summary Get all miniature projects
-// @Description Get all miniature painting projects
+// @Description Get all miniature painting projects. Descriptions follow Accept-Language.
 // @Tags Miniatures - Projects
 // @Produce json
 // @Security BearerAuth
+// @Param tag query string false "Only records with this tag (case-insensitive)"
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
 // @Success 200 {array} models.MiniatureProject
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /miniatures/projects [get]
 func (h *Handler) GetAllMiniatureProjects(c *gin.Context) {
-	projects, err := h.repo.GetAllMiniatureProjects(c.Request.Context())
+	projects, err := h.repo.GetAllMiniatureProjects(c.Request.Context(), listFilter(c))
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature projects")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature projects")
+		return
+	}
+	records := make(map[int64]interface{}, len(projects))
+	for i := range projects {
+		records[projects[i].ID] = &projects[i]
+	}
+	if err := h.localize(c, models.EntityMiniatureProject, records); err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch translations")
 		return
 	}
 
@@ -32,27 +45,33 @@ func (h *Handler) GetAllMiniatureProjects(c *gin.Context) {
 
 // GetMiniatureProjectByID godoc
 // @Summary Get miniature project by ID
-// @Description Get a single miniature project by ID
+// @Description Get a single miniature project by ID. The description follows Accept-Language.
 // @Tags Miniatures - Projects
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Miniature Project ID"
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
 // @Success 200 {object} models.MiniatureProject
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/projects/{id} [get]
 func (h *Handler) GetMiniatureProjectByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	project, err := h.repo.GetMiniatureProjectByID(c.Request.Context(), id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature project not found", "failed to fetch miniature project")
+		problem.HandleRepositoryError(c, err, "miniature project not found", "failed to fetch miniature project")
+		return
+	}
+	if err := h.localize(c, models.EntityMiniatureProject, map[int64]interface{}{project.ID: project}); err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch translations")
 		return
 	}
 
@@ -66,9 +85,13 @@ type miniatureProjectRequest struct {
 	PaintIDs     []int64 `json:"paintIds,omitempty"`
 }
 
+func validateMiniatureProjectRequest(req *miniatureProjectRequest) validation.Errors {
+	return validation.MiniatureProject(&req.MiniatureProject)
+}
+
 // CreateMiniatureProject godoc
 // @Summary Create miniature project
-// @Description Create a new miniature painting project with optional techniques and paints
+// @Description Create a new miniature painting project with optional techniques and paints. description is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.
 // @Tags Miniatures - Projects
 // @Accept json
 // @Produce json
@@ -76,34 +99,33 @@ type miniatureProjectRequest struct {
 // @Param project body miniatureProjectRequest true "Miniature project data with optional techniqueIds and paintIds"
 // @Success 201 {object} models.MiniatureProject
 // @Header 201 {string} Location "URL of the created resource"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/projects [post]
 func (h *Handler) CreateMiniatureProject(c *gin.Context) {
 	var req miniatureProjectRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &req, validateMiniatureProjectRequest) {
 		return
 	}
 
 	ctx := c.Request.Context()
 
 	if err := h.repo.CreateMiniatureProject(ctx, &req.MiniatureProject); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "", "failed to create miniature project")
+		problem.HandleRepositoryError(c, err, "", "failed to create miniature project")
 		return
 	}
 
 	// Set techniques and paints if provided
 	if len(req.TechniqueIDs) > 0 {
 		if err := h.repo.SetProjectTechniques(ctx, req.ID, req.TechniqueIDs); err != nil {
-			commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to set techniques")
+			problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to set techniques")
 			return
 		}
 	}
 	if len(req.PaintIDs) > 0 {
 		if err := h.repo.SetProjectPaints(ctx, req.ID, req.PaintIDs); err != nil {
-			commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to set paints")
+			problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to set paints")
 			return
 		}
 	}
@@ -111,17 +133,18 @@ func (h *Handler) CreateMiniatureProject(c *gin.Context) {
 	// Reload project with all associations
 	project, err := h.repo.GetMiniatureProjectByID(ctx, req.ID)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch created project")
+		problem.HandleRepositoryError(c, err, "project not found", "failed to fetch created project")
 		return
 	}
 
 	setLocationHeader(c, project.ID)
+	h.emit(c, models.EntityMiniatureProject, events.ActionCreated, project.ID)
 	c.JSON(http.StatusCreated, project)
 }
 
 // UpdateMiniatureProject godoc
 // @Summary Update miniature project
-// @Description Update an existing miniature project with optional techniques and paints
+// @Description Update an existing miniature project with optional techniques and paints. description is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.
 // @Tags Miniatures - Projects
 // @Accept json
 // @Produce json
@@ -129,20 +152,19 @@ func (h *Handler) CreateMiniatureProject(c *gin.Context) {
 // @Param id path int true "Miniature Project ID"
 // @Param project body miniatureProjectRequest true "Miniature project data with optional techniqueIds and paintIds"
 // @Success 200 {object} models.MiniatureProject
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/projects/{id} [put]
 func (h *Handler) UpdateMiniatureProject(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var req miniatureProjectRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &req, validateMiniatureProjectRequest) {
 		return
 	}
 
@@ -150,27 +172,28 @@ func (h *Handler) UpdateMiniatureProject(c *gin.Context) {
 	req.ID = id
 
 	if err := h.repo.UpdateMiniatureProject(ctx, &req.MiniatureProject); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature project not found", "failed to update miniature project")
+		problem.HandleRepositoryError(c, err, "miniature project not found", "failed to update miniature project")
 		return
 	}
 
 	// Update techniques and paints (always replace, even with empty arrays)
 	if err := h.repo.SetProjectTechniques(ctx, id, req.TechniqueIDs); err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to set techniques")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to set techniques")
 		return
 	}
 	if err := h.repo.SetProjectPaints(ctx, id, req.PaintIDs); err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to set paints")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to set paints")
 		return
 	}
 
 	// Reload project with all associations
 	project, err := h.repo.GetMiniatureProjectByID(ctx, id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch updated project")
+		problem.HandleRepositoryError(c, err, "project not found", "failed to fetch updated project")
 		return
 	}
 
+	h.emit(c, models.EntityMiniatureProject, events.ActionUpdated, id)
 	c.JSON(http.StatusOK, project)
 }
 
@@ -178,30 +201,73 @@ func (h *Handler) UpdateMiniatureProject(c *gin.Context) {
 // @Summary Delete miniature project
 // @Description Delete a miniature project and all associated data (images, techniques, paints)
 // @Description Note: This is a cascade delete - one API call deletes everything
-// @Description Actual image files in S3 are preserved and cleaned up by background job
+// @Description Actual image files in S3 are preserved; purge them later via POST /files/orphans/purge
 // @Tags Miniatures - Projects
 // @Security BearerAuth
 // @Param id path int true "Miniature Project ID"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/projects/{id} [delete]
 func (h *Handler) DeleteMiniatureProject(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	if err := h.repo.DeleteMiniatureProject(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature project not found", "failed to delete miniature project")
+		respondDeleteError(c, err, "miniature project not found", "failed to delete miniature project")
 		return
 	}
 
+	h.emit(c, models.EntityMiniatureProject, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
 
+// CloneMiniatureProject godoc
+// @Summary Clone miniature project
+// @Description Copy a miniature with its theme, techniques and paints (with notes) as a new, unfinished
+// @Description miniature titled "<title> (copy)". The completion date and time spent are cleared.
+// @Description Images are shared with the original only when includeImages=true.
+// @Description The copy starts hidden; PUT /miniatures/projects/{id}/visibility shows it.
+// @Tags Miniatures - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param includeImages query bool false "Link the copy to the same images"
+// @Success 201 {object} models.MiniatureProject
+// @Header 201 {string} Location "URL of the created resource"
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/clone [post]
+func (h *Handler) CloneMiniatureProject(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	opts, ok := cloneOptions(c)
+	if !ok {
+		return
+	}
+
+	project, err := h.repo.CloneMiniatureProject(c.Request.Context(), id, opts)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "project not found", "failed to clone project")
+		return
+	}
+
+	setCloneLocationHeader(c, project.ID)
+	h.emit(c, models.EntityMiniatureProject, events.ActionCreated, project.ID)
+	c.JSON(http.StatusCreated, project)
+}
+
 // AddImageToProject godoc
 // @Summary Add image to miniature project
 // @Description Link an uploaded image file to a miniature project with optional caption
@@ -213,15 +279,16 @@ func (h *Handler) DeleteMiniatureProject(c *gin.Context) {
 // @Param id path int true "Miniature Project ID"
 // @Param image body object{fileId=int64,caption=string} true "Image data (fileId required, caption optional)"
 // @Success 201 {object} models.MiniatureFile
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /miniatures/projects/{id}/images [post]
 func (h *Handler) AddImageToProject(c *gin.Context) {
 	projectID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid project id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid project id")
 		return
 	}
 
@@ -231,7 +298,11 @@ func (h *Handler) AddImageToProject(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		problem.RespondBindingError(c, err)
+		return
+	}
+
+	if !h.validFileRefs(c, fileRef{"fileId", filerefs.UsageGalleryImage, &req.FileID}) {
 		return
 	}
 
@@ -242,10 +313,11 @@ func (h *Handler) AddImageToProject(c *gin.Context) {
 	}
 
 	if err := h.repo.AddImageToProject(c.Request.Context(), miniatureFile); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to add image to project")
+		problem.HandleRepositoryError(c, err, "project not found", "failed to add image to project")
 		return
 	}
 
+	h.emit(c, models.EntityMiniatureProject, events.ActionUpdated, projectID)
 	c.JSON(http.StatusCreated, miniatureFile)
 }
 
@@ -259,14 +331,14 @@ func (h *Handler) AddImageToProject(c *gin.Context) {
 // @Param id path int true "Miniature Project ID"
 // @Param techniques body object{techniqueIds=[]int64} true "List of technique IDs"
 // @Success 200 {object} models.MiniatureProject
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/projects/{id}/techniques [put]
 func (h *Handler) SetProjectTechniques(c *gin.Context) {
 	projectID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid project id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid project id")
 		return
 	}
 
@@ -274,19 +346,21 @@ func (h *Handler) SetProjectTechniques(c *gin.Context) {
 		TechniqueIDs []int64 `json:"techniqueIds"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		problem.RespondBindingError(c, err)
 		return
 	}
 
 	if err := h.repo.SetProjectTechniques(c.Request.Context(), projectID, req.TechniqueIDs); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to set techniques")
+		problem.HandleRepositoryError(c, err, "project not found", "failed to set techniques")
 		return
 	}
 
+	h.emit(c, models.EntityMiniatureProject, events.ActionUpdated, projectID)
+
 	// Return updated project
 	project, err := h.repo.GetMiniatureProjectByID(c.Request.Context(), projectID)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch project")
+		problem.HandleRepositoryError(c, err, "project not found", "failed to fetch project")
 		return
 	}
 	c.JSON(http.StatusOK, project)
@@ -302,14 +376,14 @@ func (h *Handler) SetProjectTechniques(c *gin.Context) {
 // @Param id path int true "Miniature Project ID"
 // @Param paints body object{paintIds=[]int64} true "List of paint IDs"
 // @Success 200 {object} models.MiniatureProject
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/projects/{id}/paints [put]
 func (h *Handler) SetProjectPaints(c *gin.Context) {
 	projectID, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid project id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid project id")
 		return
 	}
 
@@ -317,19 +391,21 @@ func (h *Handler) SetProjectPaints(c *gin.Context) {
 		PaintIDs []int64 `json:"paintIds"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		problem.RespondBindingError(c, err)
 		return
 	}
 
 	if err := h.repo.SetProjectPaints(c.Request.Context(), projectID, req.PaintIDs); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to set paints")
+		problem.HandleRepositoryError(c, err, "project not found", "failed to set paints")
 		return
 	}
 
+	h.emit(c, models.EntityMiniatureProject, events.ActionUpdated, projectID)
+
 	// Return updated project
 	project, err := h.repo.GetMiniatureProjectByID(c.Request.Context(), projectID)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "project not found", "failed to fetch project")
+		problem.HandleRepositoryError(c, err, "project not found", "failed to fetch project")
 		return
 	}
 	c.JSON(http.StatusOK, project)
@@ -343,12 +419,12 @@ func (h *Handler) SetProjectPaints(c *gin.Context) {
 // @Security BearerAuth
 // @Success 200 {array} models.MiniatureTechnique
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /miniatures/techniques [get]
 func (h *Handler) GetAllTechniques(c *gin.Context) {
 	techniques, err := h.repo.GetAllTechniques(c.Request.Context())
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch techniques")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch techniques")
 		return
 	}
 	c.JSON(http.StatusOK, techniques)
@@ -4,9 +4,10 @@ import (
 	"net/http"
 	"strconv"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
@@ -18,12 +19,12 @@ import (
 // @Security BearerAuth
 // @Success 200 {array} models.MiniaturePaint
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /miniatures/paints [get]
 func (h *Handler) GetAllMiniaturePaints(c *gin.Context) {
 	paints, err := h.repo.GetAllMiniaturePaints(c.Request.Context())
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature paints")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature paints")
 		return
 	}
 
@@ -38,21 +39,21 @@ func (h *Handler) GetAllMiniaturePaints(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path int true "Paint ID"
 // @Success 200 {object} models.MiniaturePaint
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/paints/{id} [get]
 func (h *Handler) GetMiniaturePaintByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	paint, err := h.repo.GetMiniaturePaintByID(c.Request.Context(), id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature paint not found", "failed to fetch miniature paint")
+		problem.HandleRepositoryError(c, err, "miniature paint not found", "failed to fetch miniature paint")
 		return
 	}
 
@@ -69,23 +70,23 @@ func (h *Handler) GetMiniaturePaintByID(c *gin.Context) {
 // @Param paint body models.MiniaturePaint true "Paint data"
 // @Success 201 {object} models.MiniaturePaint
 // @Header 201 {string} Location "URL of the created resource"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/paints [post]
 func (h *Handler) CreateMiniaturePaint(c *gin.Context) {
 	var paint models.MiniaturePaint
-	if err := c.ShouldBindJSON(&paint); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &paint, validation.MiniaturePaint) {
 		return
 	}
 
 	if err := h.repo.CreateMiniaturePaint(c.Request.Context(), &paint); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "", "failed to create miniature paint")
+		problem.HandleRepositoryError(c, err, "", "failed to create miniature paint")
 		return
 	}
 
 	setLocationHeader(c, paint.ID)
+	h.emit(c, models.EntityMiniaturePaint, events.ActionCreated, paint.ID)
 	c.JSON(http.StatusCreated, paint)
 }
 
@@ -99,56 +100,89 @@ func (h *Handler) CreateMiniaturePaint(c *gin.Context) {
 // @Param id path int true "Paint ID"
 // @Param paint body models.MiniaturePaint true "Paint data"
 // @Success 200 {object} models.MiniaturePaint
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/paints/{id} [put]
 func (h *Handler) UpdateMiniaturePaint(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var paint models.MiniaturePaint
-	if err := c.ShouldBindJSON(&paint); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &paint, validation.MiniaturePaint) {
 		return
 	}
 
 	paint.ID = id
 	if err := h.repo.UpdateMiniaturePaint(c.Request.Context(), &paint); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature paint not found", "failed to update miniature paint")
+		problem.HandleRepositoryError(c, err, "miniature paint not found", "failed to update miniature paint")
 		return
 	}
 
+	h.emit(c, models.EntityMiniaturePaint, events.ActionUpdated, id)
 	c.JSON(http.StatusOK, paint)
 }
 
 // DeleteMiniaturePaint godoc
 // @Summary Delete miniature paint
-// @Description Delete a miniature paint entry
+// @Description Delete a miniature paint entry. Projects that use it block the delete (409) unless
+// @Description cascade=true removes it from them or reassignTo moves them to another paint.
 // @Tags Miniatures - Paints
 // @Security BearerAuth
 // @Param id path int true "Paint ID"
+// @Param cascade query bool false "Remove the references instead of refusing"
+// @Param reassignTo query int false "Move the references to this record instead of refusing"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/paints/{id} [delete]
 func (h *Handler) DeleteMiniaturePaint(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	opts, ok := deleteOptions(c)
+	if !ok {
 		return
 	}
 
-	if err := h.repo.DeleteMiniaturePaint(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature paint not found", "failed to delete miniature paint")
+	if err := h.repo.DeleteMiniaturePaint(c.Request.Context(), id, opts); err != nil {
+		respondDeleteError(c, err, "miniature paint not found", "failed to delete miniature paint")
 		return
 	}
 
+	h.emit(c, models.EntityMiniaturePaint, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
+
+// MergeMiniaturePaints godoc
+// @Summary Merge duplicate paints
+// @Description Repoint the miniature paint links of the source paints to this paint and delete the sources in one transaction.
+// @Description Send preview=true to get the same report without changing anything.
+// @Tags Miniatures - Paints
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Target paint ID"
+// @Param merge body models.MergeRequest true "Records to merge into the target"
+// @Success 200 {object} models.MergeResult
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/paints/{id}/merge [post]
+func (h *Handler) MergeMiniaturePaints(c *gin.Context) {
+	h.mergeInto(c, models.EntityMiniaturePaint, "miniature paint not found", "failed to merge miniature paints", h.repo.MergeMiniaturePaints)
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	defaultStatsLimit = 10
+	maxStatsLimit     = 50
+)
+
+// GetMiniatureStats godoc
+// @Summary Get miniature hobby statistics
+// @Description Get painting output totals and breakdowns: projects completed per month and year,
+// @Description total and average time spent, most used paints and techniques, and distribution
+// @Description by theme, scale, manufacturer and difficulty
+// @Description Optional from/to dates restrict all figures to projects completed in that range
+// @Tags Miniatures - Stats
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "Completed on or after (YYYY-MM-DD)"
+// @Param to query string false "Completed on or before (YYYY-MM-DD)"
+// @Param limit query int false "Max entries in top paints/techniques (default 10, max 50)"
+// @Success 200 {object} models.MiniatureStats
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /miniatures/stats [get]
+func (h *Handler) GetMiniatureStats(c *gin.Context) {
+	filter := models.MiniatureStatsFilter{Limit: defaultStatsLimit}
+
+	from, ok := parseDateQuery(c, "from")
+	if !ok {
+		return
+	}
+	to, ok := parseDateQuery(c, "to")
+	if !ok {
+		return
+	}
+	if from != nil && to != nil && *from > *to {
+		problem.RespondError(c, http.StatusBadRequest, "from must not be after to")
+		return
+	}
+	filter.From, filter.To = from, to
+
+	if raw := c.Query("limit"); raw != "" {
+		limit, err := strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > maxStatsLimit {
+			problem.RespondError(c, http.StatusBadRequest, "limit must be between 1 and 50")
+			return
+		}
+		filter.Limit = limit
+	}
+
+	stats, err := h.repo.GetMiniatureStats(c.Request.Context(), filter)
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature stats")
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
+// parseDateQuery reads an optional YYYY-MM-DD query parameter.
+// Responds with 400 and returns ok=false when the value is malformed.
+func parseDateQuery(c *gin.Context, name string) (*string, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, true
+	}
+	if _, err := time.Parse(dateLayout, raw); err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid "+name+" date, expected YYYY-MM-DD")
+		return nil, false
+	}
+	return &raw, true
+}
@@ -4,9 +4,11 @@ import (
 	"net/http"
 	"strconv"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
@@ -16,14 +18,15 @@ import (
 // @Tags Miniatures - Themes
 // @Produce json
 // @Security BearerAuth
+// @Param tag query string false "Only records with this tag (case-insensitive)"
 // @Success 200 {array} models.MiniatureTheme
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /miniatures/themes [get]
 func (h *Handler) GetAllMiniatureThemes(c *gin.Context) {
-	themes, err := h.repo.GetAllMiniatureThemes(c.Request.Context())
+	themes, err := h.repo.GetAllMiniatureThemes(c.Request.Context(), listFilter(c))
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature themes")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch miniature themes")
 		return
 	}
 
@@ -38,21 +41,21 @@ func (h *Handler) GetAllMiniatureThemes(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path int true "Miniature Theme ID"
 // @Success 200 {object} models.MiniatureTheme
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/themes/{id} [get]
 func (h *Handler) GetMiniatureThemeByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	theme, err := h.repo.GetMiniatureThemeByID(c.Request.Context(), id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature theme not found", "failed to fetch miniature theme")
+		problem.HandleRepositoryError(c, err, "miniature theme not found", "failed to fetch miniature theme")
 		return
 	}
 
@@ -69,23 +72,28 @@ func (h *Handler) GetMiniatureThemeByID(c *gin.Context) {
 // @Param theme body models.MiniatureTheme true "Miniature theme data"
 // @Success 201 {object} models.MiniatureTheme
 // @Header 201 {string} Location "URL of the created resource"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/themes [post]
 func (h *Handler) CreateMiniatureTheme(c *gin.Context) {
 	var theme models.MiniatureTheme
-	if err := c.ShouldBindJSON(&theme); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &theme, validation.MiniatureTheme) {
+		return
+	}
+
+	if !h.validFileRefs(c, fileRef{"coverImageId", filerefs.UsageThemeCover, theme.CoverImageID}) {
 		return
 	}
 
 	if err := h.repo.CreateMiniatureTheme(c.Request.Context(), &theme); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "", "failed to create miniature theme")
+		problem.HandleRepositoryError(c, err, "", "failed to create miniature theme")
 		return
 	}
 
 	setLocationHeader(c, theme.ID)
+	h.emit(c, models.EntityMiniatureTheme, events.ActionCreated, theme.ID)
 	c.JSON(http.StatusCreated, theme)
 }
 
@@ -99,54 +107,71 @@ func (h *Handler) CreateMiniatureTheme(c *gin.Context) {
 // @Param id path int true "Miniature Theme ID"
 // @Param theme body models.MiniatureTheme true "Miniature theme data"
 // @Success 200 {object} models.MiniatureTheme
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/themes/{id} [put]
 func (h *Handler) UpdateMiniatureTheme(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var theme models.MiniatureTheme
-	if err := c.ShouldBindJSON(&theme); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &theme, validation.MiniatureTheme) {
+		return
+	}
+
+	if !h.validFileRefs(c, fileRef{"coverImageId", filerefs.UsageThemeCover, theme.CoverImageID}) {
 		return
 	}
 
 	theme.ID = id
 	if err := h.repo.UpdateMiniatureTheme(c.Request.Context(), &theme); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature theme not found", "failed to update miniature theme")
+		problem.HandleRepositoryError(c, err, "miniature theme not found", "failed to update miniature theme")
 		return
 	}
 
+	h.emit(c, models.EntityMiniatureTheme, events.ActionUpdated, id)
 	c.JSON(http.StatusOK, theme)
 }
 
 // DeleteMiniatureTheme godoc
 // @Summary Delete miniature theme
-// @Description Delete a miniature theme
+// @Description Delete a miniature theme. Miniatures in the theme block the delete (409) unless
+// @Description cascade=true leaves them without a theme or reassignTo moves them to another theme.
 // @Tags Miniatures - Themes
 // @Security BearerAuth
 // @Param id path int true "Miniature Theme ID"
+// @Param cascade query bool false "Remove the references instead of refusing"
+// @Param reassignTo query int false "Move the references to this record instead of refusing"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /miniatures/themes/{id} [delete]
 func (h *Handler) DeleteMiniatureTheme(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	opts, ok := deleteOptions(c)
+	if !ok {
 		return
 	}
 
-	if err := h.repo.DeleteMiniatureTheme(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "miniature theme not found", "failed to delete miniature theme")
+	if err := h.repo.DeleteMiniatureTheme(c.Request.Context(), id, opts); err != nil {
+		respondDeleteError(c, err, "miniature theme not found", "failed to delete miniature theme")
 		return
 	}
 
+	h.emit(c, models.EntityMiniatureTheme, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
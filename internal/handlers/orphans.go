@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/filesapi"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+// GetOrphanedFiles godoc
+// @Summary List orphaned files
+// @Description List storage files not referenced by the profile, a portfolio project, a miniature theme
+// @Description or a miniature gallery. Files older than the grace period are marked purgeable.
+// @Tags Files
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.OrphanReport
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Failure 503 {object} problem.Problem
+// @Router /files/orphans [get]
+func (h *Handler) GetOrphanedFiles(c *gin.Context) {
+	if h.orphans == nil {
+		problem.RespondError(c, http.StatusServiceUnavailable, "orphaned file scanning is not enabled")
+		return
+	}
+
+	report, err := h.orphans.Scan(c.Request.Context())
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to scan orphaned files")
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// PurgeOrphanedFiles godoc
+// @Summary Purge orphaned files
+// @Description Delete purgeable orphaned files through files-api. Requires "confirm": true.
+// @Description fileIds limits the purge to those files; files linked again or still within the
+// @Description grace period are skipped. Files-api is called with the caller's token.
+// @Tags Files
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body models.OrphanPurgeRequest true "Purge confirmation"
+// @Success 200 {object} models.OrphanPurgeResult
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Failure 503 {object} problem.Problem
+// @Router /files/orphans/purge [post]
+func (h *Handler) PurgeOrphanedFiles(c *gin.Context) {
+	var req models.OrphanPurgeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.RespondBindingError(c, err)
+		return
+	}
+	if !req.Confirm {
+		problem.RespondError(c, http.StatusBadRequest, "purge must be confirmed with \"confirm\": true")
+		return
+	}
+
+	if h.orphans == nil {
+		problem.RespondError(c, http.StatusServiceUnavailable, "orphaned file scanning is not enabled")
+		return
+	}
+
+	ctx := filesapi.WithToken(c.Request.Context(), accessToken(c))
+	result, err := h.orphans.Purge(ctx, req.FileIDs)
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to purge orphaned files")
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// accessToken returns the caller's token the same way the auth middleware
+// reads it: the access_token cookie first, then the Bearer header
+func accessToken(c *gin.Context) string {
+	if cookie, err := c.Cookie("access_token"); err == nil && cookie != "" {
+		return cookie
+	}
+	if scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " "); ok && scheme == "Bearer" {
+		return token
+	}
+	return ""
+}
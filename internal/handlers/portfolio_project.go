@@ -4,26 +4,39 @@ import (
 	"net/http"
 	"strconv"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
 // GetAllPortfolioProjects godoc
 // @Summary Get all portfolio projects
-// @Description Get all portfolio projects
+// @Description Get all portfolio projects. Translatable fields follow Accept-Language.
 // @Tags Portfolio - Projects
 // @Produce json
 // @Security BearerAuth
+// @Param tag query string false "Only records with this tag (case-insensitive)"
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
 // @Success 200 {array} models.PortfolioProject
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /portfolio/projects [get]
 func (h *Handler) GetAllPortfolioProjects(c *gin.Context) {
-	projects, err := h.repo.GetAllPortfolioProjects(c.Request.Context())
+	projects, err := h.repo.GetAllPortfolioProjects(c.Request.Context(), listFilter(c))
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch portfolio projects")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch portfolio projects")
+		return
+	}
+	records := make(map[int64]interface{}, len(projects))
+	for i := range projects {
+		records[projects[i].ID] = &projects[i]
+	}
+	if err := h.localize(c, models.EntityPortfolioProject, records); err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch translations")
 		return
 	}
 
@@ -32,27 +45,33 @@ func (h *Handler) GetAllPortfolioProjects(c *gin.Context) {
 
 // GetPortfolioProjectByID godoc
 // @Summary Get portfolio project by ID
-// @Description Get a single portfolio project by ID
+// @Description Get a single portfolio project by ID. Translatable fields follow Accept-Language.
 // @Tags Portfolio - Projects
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Portfolio Project ID"
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
 // @Success 200 {object} models.PortfolioProject
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/projects/{id} [get]
 func (h *Handler) GetPortfolioProjectByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	project, err := h.repo.GetPortfolioProjectByID(c.Request.Context(), id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "portfolio project not found", "failed to fetch portfolio project")
+		problem.HandleRepositoryError(c, err, "portfolio project not found", "failed to fetch portfolio project")
+		return
+	}
+	if err := h.localize(c, models.EntityPortfolioProject, map[int64]interface{}{project.ID: project}); err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch translations")
 		return
 	}
 
@@ -61,7 +80,7 @@ func (h *Handler) GetPortfolioProjectByID(c *gin.Context) {
 
 // CreatePortfolioProject godoc
 // @Summary Create portfolio project
-// @Description Create a new portfolio project
+// @Description Create a new portfolio project. longDescription is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.
 // @Tags Portfolio - Projects
 // @Accept json
 // @Produce json
@@ -69,29 +88,34 @@ func (h *Handler) GetPortfolioProjectByID(c *gin.Context) {
 // @Param project body models.PortfolioProject true "Portfolio project data"
 // @Success 201 {object} models.PortfolioProject
 // @Header 201 {string} Location "URL of the created resource"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/projects [post]
 func (h *Handler) CreatePortfolioProject(c *gin.Context) {
 	var project models.PortfolioProject
-	if err := c.ShouldBindJSON(&project); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &project, validation.PortfolioProject) {
+		return
+	}
+
+	if !h.validFileRefs(c, fileRef{"imageFileId", filerefs.UsageProjectImage, project.ImageFileID}) {
 		return
 	}
 
 	if err := h.repo.CreatePortfolioProject(c.Request.Context(), &project); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "", "failed to create portfolio project")
+		problem.HandleRepositoryError(c, err, "", "failed to create portfolio project")
 		return
 	}
 
 	setLocationHeader(c, project.ID)
+	h.emit(c, models.EntityPortfolioProject, events.ActionCreated, project.ID)
 	c.JSON(http.StatusCreated, project)
 }
 
 // UpdatePortfolioProject godoc
 // @Summary Update portfolio project
-// @Description Update an existing portfolio project
+// @Description Update an existing portfolio project. longDescription is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.
 // @Tags Portfolio - Projects
 // @Accept json
 // @Produce json
@@ -99,29 +123,34 @@ func (h *Handler) CreatePortfolioProject(c *gin.Context) {
 // @Param id path int true "Portfolio Project ID"
 // @Param project body models.PortfolioProject true "Portfolio project data"
 // @Success 200 {object} models.PortfolioProject
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/projects/{id} [put]
 func (h *Handler) UpdatePortfolioProject(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var project models.PortfolioProject
-	if err := c.ShouldBindJSON(&project); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &project, validation.PortfolioProject) {
+		return
+	}
+
+	if !h.validFileRefs(c, fileRef{"imageFileId", filerefs.UsageProjectImage, project.ImageFileID}) {
 		return
 	}
 
 	project.ID = id
 	if err := h.repo.UpdatePortfolioProject(c.Request.Context(), &project); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "portfolio project not found", "failed to update portfolio project")
+		problem.HandleRepositoryError(c, err, "portfolio project not found", "failed to update portfolio project")
 		return
 	}
 
+	h.emit(c, models.EntityPortfolioProject, events.ActionUpdated, id)
 	c.JSON(http.StatusOK, project)
 }
 
@@ -129,26 +158,68 @@ func (h *Handler) UpdatePortfolioProject(c *gin.Context) {
 // @Summary Delete portfolio project
 // @Description Delete a portfolio project and all associated technology links
 // @Description Note: This is a cascade delete - one API call deletes the project and all technology associations
-// @Description The project image file in S3 is preserved and cleaned up by background job
+// @Description The project image file in S3 is preserved; purge it later via POST /files/orphans/purge
 // @Tags Portfolio - Projects
 // @Security BearerAuth
 // @Param id path int true "Portfolio Project ID"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/projects/{id} [delete]
 func (h *Handler) DeletePortfolioProject(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	if err := h.repo.DeletePortfolioProject(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "portfolio project not found", "failed to delete portfolio project")
+		respondDeleteError(c, err, "portfolio project not found", "failed to delete portfolio project")
 		return
 	}
 
+	h.emit(c, models.EntityPortfolioProject, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
+
+// ClonePortfolioProject godoc
+// @Summary Clone portfolio project
+// @Description Copy a portfolio project with its technologies as a new project titled "<title> (copy)".
+// @Description The copy is not featured and keeps the image only when includeImages=true.
+// @Description The copy starts hidden; PUT /portfolio/projects/{id}/visibility shows it.
+// @Tags Portfolio - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param includeImages query bool false "Keep the project image"
+// @Success 201 {object} models.PortfolioProject
+// @Header 201 {string} Location "URL of the created resource"
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/clone [post]
+func (h *Handler) ClonePortfolioProject(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	opts, ok := cloneOptions(c)
+	if !ok {
+		return
+	}
+
+	project, err := h.repo.ClonePortfolioProject(c.Request.Context(), id, opts)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "portfolio project not found", "failed to clone portfolio project")
+		return
+	}
+
+	setCloneLocationHeader(c, project.ID)
+	h.emit(c, models.EntityPortfolioProject, events.ActionCreated, project.ID)
+	c.JSON(http.StatusCreated, project)
+}
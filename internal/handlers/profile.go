@@ -3,27 +3,35 @@ package handlers
 import (
 	"net/http"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
 // GetProfile godoc
 // @Summary Get profile
-// @Description Get profile information
+// @Description Get profile information. Title and tagline follow Accept-Language.
 // @Tags Portfolio - Profile
 // @Produce json
 // @Security BearerAuth
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
 // @Success 200 {object} models.Profile
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/profile [get]
 func (h *Handler) GetProfile(c *gin.Context) {
 	profile, err := h.repo.GetProfile(c.Request.Context())
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "profile not found", "failed to fetch profile")
+		problem.HandleRepositoryError(c, err, "profile not found", "failed to fetch profile")
+		return
+	}
+	if err := h.localize(c, models.EntityProfile, map[int64]interface{}{profile.ID: profile}); err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch translations")
 		return
 	}
 
@@ -39,21 +47,29 @@ func (h *Handler) GetProfile(c *gin.Context) {
 // @Security BearerAuth
 // @Param profile body models.Profile true "Profile data"
 // @Success 200 {object} models.Profile
-// @Failure 400 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/profile [put]
 func (h *Handler) UpdateProfile(c *gin.Context) {
 	var profile models.Profile
-	if err := c.ShouldBindJSON(&profile); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &profile, validation.Profile) {
+		return
+	}
+
+	if !h.validFileRefs(c,
+		fileRef{"avatarFileId", filerefs.UsageAvatar, profile.AvatarFileID},
+		fileRef{"resumeFileId", filerefs.UsageResume, profile.ResumeFileID},
+	) {
 		return
 	}
 
 	if err := h.repo.UpdateProfile(c.Request.Context(), &profile); err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to update profile")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to update profile")
 		return
 	}
 
+	h.emit(c, models.EntityProfile, events.ActionUpdated, profile.ID)
 	c.JSON(http.StatusOK, profile)
 }
 
@@ -65,8 +81,9 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param fileId body object{fileId=int64} true "File ID"
-// @Success 200 {object} map[string]string
-// @Failure 400 {object} map[string]string
+// @Success 200 {object} problem.Problem
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/profile/avatar [put]
 func (h *Handler) UpdateProfileAvatar(c *gin.Context) {
@@ -75,15 +92,20 @@ func (h *Handler) UpdateProfileAvatar(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		problem.RespondBindingError(c, err)
+		return
+	}
+
+	if !h.validFileRefs(c, fileRef{"fileId", filerefs.UsageAvatar, &request.FileID}) {
 		return
 	}
 
 	if err := h.repo.UpdateProfileAvatar(c.Request.Context(), request.FileID); err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to update avatar")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to update avatar")
 		return
 	}
 
+	h.emit(c, models.EntityProfile, events.ActionUpdated, 0)
 	c.JSON(http.StatusOK, gin.H{"message": "avatar updated successfully"})
 }
 
@@ -97,10 +119,11 @@ func (h *Handler) UpdateProfileAvatar(c *gin.Context) {
 // @Router /portfolio/profile/avatar [delete]
 func (h *Handler) DeleteProfileAvatar(c *gin.Context) {
 	if err := h.repo.DeleteProfileAvatar(c.Request.Context()); err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to delete avatar")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to delete avatar")
 		return
 	}
 
+	h.emit(c, models.EntityProfile, events.ActionUpdated, 0)
 	c.Status(http.StatusNoContent)
 }
 
@@ -112,8 +135,9 @@ func (h *Handler) DeleteProfileAvatar(c *gin.Context) {
 // @Produce json
 // @Security BearerAuth
 // @Param fileId body object{fileId=int64} true "File ID"
-// @Success 200 {object} map[string]string
-// @Failure 400 {object} map[string]string
+// @Success 200 {object} problem.Problem
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/profile/resume [put]
 func (h *Handler) UpdateProfileResume(c *gin.Context) {
@@ -122,15 +146,20 @@ func (h *Handler) UpdateProfileResume(c *gin.Context) {
 	}
 
 	if err := c.ShouldBindJSON(&request); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+		problem.RespondBindingError(c, err)
+		return
+	}
+
+	if !h.validFileRefs(c, fileRef{"fileId", filerefs.UsageResume, &request.FileID}) {
 		return
 	}
 
 	if err := h.repo.UpdateProfileResume(c.Request.Context(), request.FileID); err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to update resume")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to update resume")
 		return
 	}
 
+	h.emit(c, models.EntityProfile, events.ActionUpdated, 0)
 	c.JSON(http.StatusOK, gin.H{"message": "resume updated successfully"})
 }
 
@@ -144,9 +173,10 @@ func (h *Handler) UpdateProfileResume(c *gin.Context) {
 // @Router /portfolio/profile/resume [delete]
 func (h *Handler) DeleteProfileResume(c *gin.Context) {
 	if err := h.repo.DeleteProfileResume(c.Request.Context()); err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to delete resume")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to delete resume")
 		return
 	}
 
+	h.emit(c, models.EntityProfile, events.ActionUpdated, 0)
 	c.Status(http.StatusNoContent)
 }
@@ -0,0 +1,76 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultSearchLimit   = 20
+	maxSearchLimit       = 100
+	maxSearchQueryLength = 200
+)
+
+// Search godoc
+// @Summary Search admin content
+// @Description Full-text search over portfolio projects, work experience, certifications, skills,
+// @Description miniature projects and themes. q accepts web search syntax: "quoted phrases", or, -excluded.
+// @Description Hits are ranked best first and carry a snippet with the matches wrapped in <mark>.
+// @Description Only entities the caller has read permission on are searched.
+// @Tags Search
+// @Produce json
+// @Security BearerAuth
+// @Param q query string true "Search text"
+// @Param limit query int false "Maximum number of hits (default 20, max 100)"
+// @Success 200 {object} models.SearchResults
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /search [get]
+func (h *Handler) Search(c *gin.Context) {
+	var fields []problem.FieldError
+	text := strings.TrimSpace(c.Query("q"))
+	switch {
+	case text == "":
+		fields = append(fields, problem.FieldError{Field: "q", Message: "is required"})
+	case utf8.RuneCountInString(text) > maxSearchQueryLength:
+		fields = append(fields, problem.FieldError{Field: "q", Message: "must be at most 200 characters long"})
+	}
+	limit := defaultSearchLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxSearchLimit {
+			fields = append(fields, problem.FieldError{Field: "limit", Message: "must be between 1 and 100"})
+		}
+		limit = parsed
+	}
+	if len(fields) > 0 {
+		problem.RespondFieldErrors(c, http.StatusBadRequest, "invalid search query", fields...)
+		return
+	}
+
+	// Search only what the caller may read, so ranking and limit apply to visible hits
+	var entities []string
+	for _, entity := range models.SearchableEntities {
+		if canRead(c, entity) {
+			entities = append(entities, entity)
+		}
+	}
+
+	hits, err := h.repo.Search(c.Request.Context(), models.SearchQuery{Text: text, Entities: entities, Limit: limit})
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to search")
+		return
+	}
+	for i := range hits {
+		hits[i].Path = entityPath(hits[i].Entity, hits[i].EntityID)
+	}
+
+	c.JSON(http.StatusOK, models.SearchResults{Query: text, Hits: hits})
+}
@@ -0,0 +1,292 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// getSEO responds with the SEO metadata of the record addressed by :id
+func (h *Handler) getSEO(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+	h.respondSEO(c, entity, id, notFoundDetail)
+}
+
+// setSEO stores the SEO overrides of the record addressed by :id
+func (h *Handler) setSEO(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+	h.saveSEO(c, entity, id, notFoundDetail)
+}
+
+// respondSEO responds with the resolved SEO metadata of one record
+func (h *Handler) respondSEO(c *gin.Context, entity string, id int64, notFoundDetail string) {
+	meta, err := h.repo.GetSEO(c.Request.Context(), entity, id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to fetch SEO metadata")
+		return
+	}
+
+	c.JSON(http.StatusOK, meta)
+}
+
+// saveSEO binds and stores the SEO overrides of one record and responds with
+// the resolved metadata
+func (h *Handler) saveSEO(c *gin.Context, entity string, id int64, notFoundDetail string) {
+	var meta models.SEOMetadata
+	if !bindValid(c, &meta, validation.SEOMetadata) {
+		return
+	}
+
+	if !h.validFileRefs(c, fileRef{"ogImageFileId", filerefs.UsageSocialImage, meta.OGImageFileID}) {
+		return
+	}
+
+	meta.Entity = entity
+	meta.EntityID = id
+	if err := h.repo.SetSEO(c.Request.Context(), &meta); err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to save SEO metadata")
+		return
+	}
+
+	h.emit(c, entity, events.ActionUpdated, id)
+	h.respondSEO(c, entity, id, notFoundDetail)
+}
+
+// CheckSEO godoc
+// @Summary Check SEO metadata
+// @Description List the records whose resolved SEO metadata has issues: missing or too long titles and descriptions, missing preview images. Only entities the caller can read are checked.
+// @Tags SEO
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.SEOReport
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /seo/check [get]
+func (h *Handler) CheckSEO(c *gin.Context) {
+	report := models.SEOReport{Items: []models.SEO{}}
+	for _, entity := range models.SEOEntities {
+		if !canRead(c, entity) {
+			continue
+		}
+		items, err := h.repo.GetAllSEO(c.Request.Context(), entity)
+		if err != nil {
+			problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to check SEO metadata")
+			return
+		}
+		report.Checked += len(items)
+		for _, item := range items {
+			if len(item.Issues) > 0 {
+				item.Path = entityPath(entity, item.EntityID)
+				report.Items = append(report.Items, item)
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// GetProfileSEO godoc
+// @Summary Get profile SEO metadata
+// @Description Get the SEO metadata of the profile page: stored overrides, with defaults from the name and title, bio and avatar
+// @Tags Portfolio - Profile
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.SEO
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/profile/seo [get]
+func (h *Handler) GetProfileSEO(c *gin.Context) {
+	profile, err := h.repo.GetProfile(c.Request.Context())
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "profile not found", "failed to fetch profile")
+		return
+	}
+	h.respondSEO(c, models.EntityProfile, profile.ID, "profile not found")
+}
+
+// SetProfileSEO godoc
+// @Summary Set profile SEO metadata
+// @Description Replace the SEO overrides of the profile page; empty fields use the defaults
+// @Tags Portfolio - Profile
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param seo body models.SEOMetadata true "SEO overrides"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/profile/seo [put]
+func (h *Handler) SetProfileSEO(c *gin.Context) {
+	profile, err := h.repo.GetProfile(c.Request.Context())
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "profile not found", "failed to fetch profile")
+		return
+	}
+	h.saveSEO(c, models.EntityProfile, profile.ID, "profile not found")
+}
+
+// GetPortfolioProjectSEO godoc
+// @Summary Get portfolio project SEO metadata
+// @Description Get the SEO metadata of a portfolio project: stored overrides, with defaults from its title, description and image
+// @Tags Portfolio - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/seo [get]
+func (h *Handler) GetPortfolioProjectSEO(c *gin.Context) {
+	h.getSEO(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// SetPortfolioProjectSEO godoc
+// @Summary Set portfolio project SEO metadata
+// @Description Replace the SEO overrides of a portfolio project; empty fields use the defaults
+// @Tags Portfolio - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param seo body models.SEOMetadata true "SEO overrides"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/seo [put]
+func (h *Handler) SetPortfolioProjectSEO(c *gin.Context) {
+	h.setSEO(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// GetMiniatureProjectSEO godoc
+// @Summary Get miniature project SEO metadata
+// @Description Get the SEO metadata of a miniature project: stored overrides, with defaults from its title, description and first gallery image
+// @Tags Miniatures - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/seo [get]
+func (h *Handler) GetMiniatureProjectSEO(c *gin.Context) {
+	h.getSEO(c, models.EntityMiniatureProject, "project not found")
+}
+
+// SetMiniatureProjectSEO godoc
+// @Summary Set miniature project SEO metadata
+// @Description Replace the SEO overrides of a miniature project; empty fields use the defaults
+// @Tags Miniatures - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Param seo body models.SEOMetadata true "SEO overrides"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/seo [put]
+func (h *Handler) SetMiniatureProjectSEO(c *gin.Context) {
+	h.setSEO(c, models.EntityMiniatureProject, "project not found")
+}
+
+// GetMiniatureThemeSEO godoc
+// @Summary Get miniature theme SEO metadata
+// @Description Get the SEO metadata of a miniature theme: stored overrides, with defaults from its name, description and cover image
+// @Tags Miniatures - Themes
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Theme ID"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/themes/{id}/seo [get]
+func (h *Handler) GetMiniatureThemeSEO(c *gin.Context) {
+	h.getSEO(c, models.EntityMiniatureTheme, "miniature theme not found")
+}
+
+// SetMiniatureThemeSEO godoc
+// @Summary Set miniature theme SEO metadata
+// @Description Replace the SEO overrides of a miniature theme; empty fields use the defaults
+// @Tags Miniatures - Themes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Theme ID"
+// @Param seo body models.SEOMetadata true "SEO overrides"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/themes/{id}/seo [put]
+func (h *Handler) SetMiniatureThemeSEO(c *gin.Context) {
+	h.setSEO(c, models.EntityMiniatureTheme, "miniature theme not found")
+}
+
+// GetArticleSEO godoc
+// @Summary Get article SEO metadata
+// @Description Get the SEO metadata of a blog article: stored overrides, with defaults from its title, summary (or body) and cover image
+// @Tags Blog - Articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id}/seo [get]
+func (h *Handler) GetArticleSEO(c *gin.Context) {
+	h.getSEO(c, models.EntityArticle, "article not found")
+}
+
+// SetArticleSEO godoc
+// @Summary Set article SEO metadata
+// @Description Replace the SEO overrides of a blog article; empty fields use the defaults
+// @Tags Blog - Articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Param seo body models.SEOMetadata true "SEO overrides"
+// @Success 200 {object} models.SEO
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id}/seo [put]
+func (h *Handler) SetArticleSEO(c *gin.Context) {
+	h.setSEO(c, models.EntityArticle, "article not found")
+}
@@ -4,9 +4,10 @@ import (
 	"net/http"
 	"strconv"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
@@ -20,12 +21,12 @@ import (
 // @Security BearerAuth
 // @Success 200 {array} models.Skill
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /portfolio/skills [get]
 func (h *Handler) GetAllSkills(c *gin.Context) {
 	skills, err := h.repo.GetAllSkills(c.Request.Context())
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch skills")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch skills")
 		return
 	}
 
@@ -40,21 +41,21 @@ func (h *Handler) GetAllSkills(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path int true "Skill ID"
 // @Success 200 {object} models.Skill
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/skills/{id} [get]
 func (h *Handler) GetSkillByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	skill, err := h.repo.GetSkillByID(c.Request.Context(), id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "skill not found", "failed to fetch skill")
+		problem.HandleRepositoryError(c, err, "skill not found", "failed to fetch skill")
 		return
 	}
 
@@ -71,23 +72,23 @@ func (h *Handler) GetSkillByID(c *gin.Context) {
 // @Param skill body models.Skill true "Skill data"
 // @Success 201 {object} models.Skill
 // @Header 201 {string} Location "URL of the created resource"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/skills [post]
 func (h *Handler) CreateSkill(c *gin.Context) {
 	var skill models.Skill
-	if err := c.ShouldBindJSON(&skill); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &skill, validation.Skill) {
 		return
 	}
 
 	if err := h.repo.CreateSkill(c.Request.Context(), &skill); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "", "failed to create skill")
+		problem.HandleRepositoryError(c, err, "", "failed to create skill")
 		return
 	}
 
 	setLocationHeader(c, skill.ID)
+	h.emit(c, models.EntitySkill, events.ActionCreated, skill.ID)
 	c.JSON(http.StatusCreated, skill)
 }
 
@@ -101,58 +102,92 @@ func (h *Handler) CreateSkill(c *gin.Context) {
 // @Param id path int true "Skill ID"
 // @Param skill body models.Skill true "Skill data"
 // @Success 200 {object} models.Skill
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/skills/{id} [put]
 func (h *Handler) UpdateSkill(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var skill models.Skill
-	if err := c.ShouldBindJSON(&skill); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &skill, validation.Skill) {
 		return
 	}
 
 	skill.ID = id
 	if err := h.repo.UpdateSkill(c.Request.Context(), &skill); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "skill not found", "failed to update skill")
+		problem.HandleRepositoryError(c, err, "skill not found", "failed to update skill")
 		return
 	}
 
+	h.emit(c, models.EntitySkill, events.ActionUpdated, id)
 	c.JSON(http.StatusOK, skill)
 }
 
 // DeleteSkill godoc
 // @Summary Delete skill
-// @Description Delete a skill
+// @Description Delete a skill. Projects that still list it block the delete (409) unless cascade=true
+// @Description removes it from those projects or reassignTo moves them to another skill.
 // @Tags Portfolio - Skills
 // @Security BearerAuth
 // @Param id path int true "Skill ID"
+// @Param cascade query bool false "Remove the references instead of refusing"
+// @Param reassignTo query int false "Move the references to this record instead of refusing"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/skills/{id} [delete]
 func (h *Handler) DeleteSkill(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	opts, ok := deleteOptions(c)
+	if !ok {
 		return
 	}
 
-	if err := h.repo.DeleteSkill(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "skill not found", "failed to delete skill")
+	if err := h.repo.DeleteSkill(c.Request.Context(), id, opts); err != nil {
+		respondDeleteError(c, err, "skill not found", "failed to delete skill")
 		return
 	}
 
+	h.emit(c, models.EntitySkill, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
 
+// MergeSkills godoc
+// @Summary Merge duplicate skills
+// @Description Repoint the project technologies of the source skills to this skill and delete the sources in one transaction.
+// @Description Send preview=true to get the same report without changing anything.
+// @Tags Portfolio - Skills
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Target skill ID"
+// @Param merge body models.MergeRequest true "Records to merge into the target"
+// @Success 200 {object} models.MergeResult
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/skills/{id}/merge [post]
+func (h *Handler) MergeSkills(c *gin.Context) {
+	h.mergeInto(c, models.EntitySkill, "skill not found", "failed to merge skills", h.repo.MergeSkills)
+}
+
 // SKILL TYPES
 
 // GetAllSkillTypes godoc
@@ -163,12 +198,12 @@ func (h *Handler) DeleteSkill(c *gin.Context) {
 // @Security BearerAuth
 // @Success 200 {array} models.SkillType
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /portfolio/skill-types [get]
 func (h *Handler) GetAllSkillTypes(c *gin.Context) {
 	skillTypes, err := h.repo.GetAllSkillTypes(c.Request.Context())
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch skill types")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch skill types")
 		return
 	}
 
@@ -183,21 +218,21 @@ func (h *Handler) GetAllSkillTypes(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path int true "Skill Type ID"
 // @Success 200 {object} models.SkillType
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/skill-types/{id} [get]
 func (h *Handler) GetSkillTypeByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	skillType, err := h.repo.GetSkillTypeByID(c.Request.Context(), id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "skill type not found", "failed to fetch skill type")
+		problem.HandleRepositoryError(c, err, "skill type not found", "failed to fetch skill type")
 		return
 	}
 
@@ -214,23 +249,23 @@ func (h *Handler) GetSkillTypeByID(c *gin.Context) {
 // @Param skillType body models.SkillType true "Skill type data"
 // @Success 201 {object} models.SkillType
 // @Header 201 {string} Location "URL of the created resource"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/skill-types [post]
 func (h *Handler) CreateSkillType(c *gin.Context) {
 	var skillType models.SkillType
-	if err := c.ShouldBindJSON(&skillType); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &skillType, validation.SkillType) {
 		return
 	}
 
 	if err := h.repo.CreateSkillType(c.Request.Context(), &skillType); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "", "failed to create skill type")
+		problem.HandleRepositoryError(c, err, "", "failed to create skill type")
 		return
 	}
 
 	setLocationHeader(c, skillType.ID)
+	h.emit(c, models.EntitySkillType, events.ActionCreated, skillType.ID)
 	c.JSON(http.StatusCreated, skillType)
 }
 
@@ -244,54 +279,88 @@ func (h *Handler) CreateSkillType(c *gin.Context) {
 // @Param id path int true "Skill Type ID"
 // @Param skillType body models.SkillType true "Skill type data"
 // @Success 200 {object} models.SkillType
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/skill-types/{id} [put]
 func (h *Handler) UpdateSkillType(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var skillType models.SkillType
-	if err := c.ShouldBindJSON(&skillType); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &skillType, validation.SkillType) {
 		return
 	}
 
 	skillType.ID = id
 	if err := h.repo.UpdateSkillType(c.Request.Context(), &skillType); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "skill type not found", "failed to update skill type")
+		problem.HandleRepositoryError(c, err, "skill type not found", "failed to update skill type")
 		return
 	}
 
+	h.emit(c, models.EntitySkillType, events.ActionUpdated, id)
 	c.JSON(http.StatusOK, skillType)
 }
 
 // DeleteSkillType godoc
 // @Summary Delete skill type
-// @Description Delete a skill type
+// @Description Delete a skill type. Skills of this type block the delete (409) unless cascade=true
+// @Description deletes them too or reassignTo moves them to another skill type.
 // @Tags Portfolio - Skills
 // @Security BearerAuth
 // @Param id path int true "Skill Type ID"
+// @Param cascade query bool false "Remove the references instead of refusing"
+// @Param reassignTo query int false "Move the references to this record instead of refusing"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/skill-types/{id} [delete]
 func (h *Handler) DeleteSkillType(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
-	if err := h.repo.DeleteSkillType(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "skill type not found", "failed to delete skill type")
+	opts, ok := deleteOptions(c)
+	if !ok {
 		return
 	}
 
+	if err := h.repo.DeleteSkillType(c.Request.Context(), id, opts); err != nil {
+		respondDeleteError(c, err, "skill type not found", "failed to delete skill type")
+		return
+	}
+
+	h.emit(c, models.EntitySkillType, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
+
+// MergeSkillTypes godoc
+// @Summary Merge duplicate skill types
+// @Description Move the skills of the source skill types to this type and delete the sources in one transaction.
+// @Description Send preview=true to get the same report without changing anything.
+// @Tags Portfolio - Skills
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Target skill type ID"
+// @Param merge body models.MergeRequest true "Records to merge into the target"
+// @Success 200 {object} models.MergeResult
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/skill-types/{id}/merge [post]
+func (h *Handler) MergeSkillTypes(c *gin.Context) {
+	h.mergeInto(c, models.EntitySkillType, "skill type not found", "failed to merge skill types", h.repo.MergeSkillTypes)
+}
@@ -0,0 +1,338 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/repository"
+	"github.com/GunarsK-portfolio/admin-api/internal/slug"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// getBySlug responds with the record addressed by :slug. A previous slug is
+// answered with 301 to the lookup by the current one.
+func (h *Handler) getBySlug(c *gin.Context, entity, notFoundDetail string, get func(ctx context.Context, id int64) (interface{}, error)) {
+	value := c.Param("slug")
+	if !slug.Valid(value) {
+		problem.RespondError(c, http.StatusNotFound, notFoundDetail)
+		return
+	}
+
+	ctx := c.Request.Context()
+	current, err := h.repo.ResolveSlug(ctx, entity, value)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to resolve slug")
+		return
+	}
+	if current.Slug != value {
+		c.Redirect(http.StatusMovedPermanently, path.Join(path.Dir(c.Request.URL.Path), current.Slug))
+		return
+	}
+
+	record, err := get(ctx, current.EntityID)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to fetch record")
+		return
+	}
+
+	c.JSON(http.StatusOK, record)
+}
+
+// getEntitySlugs responds with the slugs of the record addressed by :id
+func (h *Handler) getEntitySlugs(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	slugs, err := h.repo.GetEntitySlugs(c.Request.Context(), entity, id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to fetch slugs")
+		return
+	}
+
+	c.JSON(http.StatusOK, slugs)
+}
+
+// setEntitySlug changes the slug of the record addressed by :id and responds
+// with its slugs
+func (h *Handler) setEntitySlug(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req models.SlugRequest
+	if !bindValid(c, &req, validation.SlugRequest) {
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.repo.SetEntitySlug(ctx, entity, id, req.Slug); err != nil {
+		if errors.Is(err, repository.ErrSlugTaken) {
+			problem.RespondFieldErrors(c, http.StatusConflict, "slug already in use",
+				problem.FieldError{Field: "slug", Message: "is used by another record, now or before"})
+			return
+		}
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to set slug")
+		return
+	}
+
+	h.emit(c, entity, events.ActionUpdated, id)
+
+	slugs, err := h.repo.GetEntitySlugs(ctx, entity, id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to fetch slugs")
+		return
+	}
+	c.JSON(http.StatusOK, slugs)
+}
+
+// GetPortfolioProjectBySlug godoc
+// @Summary Get portfolio project by slug
+// @Description Get a portfolio project by its current slug. A previous slug redirects (301) to the current one. Translatable fields follow Accept-Language.
+// @Tags Portfolio - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Project slug"
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
+// @Success 200 {object} models.PortfolioProject
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
+// @Success 301 "Previous slug; Location is the lookup by the current slug"
+// @Header 301 {string} Location "URL of the lookup by the current slug"
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/slug/{slug} [get]
+func (h *Handler) GetPortfolioProjectBySlug(c *gin.Context) {
+	h.getBySlug(c, models.EntityPortfolioProject, "portfolio project not found", func(ctx context.Context, id int64) (interface{}, error) {
+		project, err := h.repo.GetPortfolioProjectByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return project, h.localize(c, models.EntityPortfolioProject, map[int64]interface{}{id: project})
+	})
+}
+
+// GetPortfolioProjectSlugs godoc
+// @Summary Get portfolio project slugs
+// @Description Get the current slug of a portfolio project and the slugs it replaced
+// @Tags Portfolio - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} models.Slugs
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/slugs [get]
+func (h *Handler) GetPortfolioProjectSlugs(c *gin.Context) {
+	h.getEntitySlugs(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// SetPortfolioProjectSlug godoc
+// @Summary Set portfolio project slug
+// @Description Change the slug of a portfolio project. The old slug keeps redirecting.
+// @Tags Portfolio - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param slug body models.SlugRequest true "New slug"
+// @Success 200 {object} models.Slugs
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/slug [put]
+func (h *Handler) SetPortfolioProjectSlug(c *gin.Context) {
+	h.setEntitySlug(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// GetMiniatureProjectBySlug godoc
+// @Summary Get miniature project by slug
+// @Description Get a miniature project by its current slug. A previous slug redirects (301) to the current one. The description follows Accept-Language.
+// @Tags Miniatures - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Miniature Project slug"
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
+// @Success 200 {object} models.MiniatureProject
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
+// @Success 301 "Previous slug; Location is the lookup by the current slug"
+// @Header 301 {string} Location "URL of the lookup by the current slug"
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/slug/{slug} [get]
+func (h *Handler) GetMiniatureProjectBySlug(c *gin.Context) {
+	h.getBySlug(c, models.EntityMiniatureProject, "project not found", func(ctx context.Context, id int64) (interface{}, error) {
+		project, err := h.repo.GetMiniatureProjectByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return project, h.localize(c, models.EntityMiniatureProject, map[int64]interface{}{id: project})
+	})
+}
+
+// GetMiniatureProjectSlugs godoc
+// @Summary Get miniature project slugs
+// @Description Get the current slug of a miniature project and the slugs it replaced
+// @Tags Miniatures - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Success 200 {object} models.Slugs
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/slugs [get]
+func (h *Handler) GetMiniatureProjectSlugs(c *gin.Context) {
+	h.getEntitySlugs(c, models.EntityMiniatureProject, "project not found")
+}
+
+// SetMiniatureProjectSlug godoc
+// @Summary Set miniature project slug
+// @Description Change the slug of a miniature project. The old slug keeps redirecting.
+// @Tags Miniatures - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Param slug body models.SlugRequest true "New slug"
+// @Success 200 {object} models.Slugs
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/slug [put]
+func (h *Handler) SetMiniatureProjectSlug(c *gin.Context) {
+	h.setEntitySlug(c, models.EntityMiniatureProject, "project not found")
+}
+
+// GetMiniatureThemeBySlug godoc
+// @Summary Get miniature theme by slug
+// @Description Get a miniature theme by its current slug. A previous slug redirects (301) to the current one.
+// @Tags Miniatures - Themes
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Miniature Theme slug"
+// @Success 200 {object} models.MiniatureTheme
+// @Success 301 "Previous slug; Location is the lookup by the current slug"
+// @Header 301 {string} Location "URL of the lookup by the current slug"
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/themes/slug/{slug} [get]
+func (h *Handler) GetMiniatureThemeBySlug(c *gin.Context) {
+	h.getBySlug(c, models.EntityMiniatureTheme, "miniature theme not found", func(ctx context.Context, id int64) (interface{}, error) {
+		return h.repo.GetMiniatureThemeByID(ctx, id)
+	})
+}
+
+// GetMiniatureThemeSlugs godoc
+// @Summary Get miniature theme slugs
+// @Description Get the current slug of a miniature theme and the slugs it replaced
+// @Tags Miniatures - Themes
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Theme ID"
+// @Success 200 {object} models.Slugs
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/themes/{id}/slugs [get]
+func (h *Handler) GetMiniatureThemeSlugs(c *gin.Context) {
+	h.getEntitySlugs(c, models.EntityMiniatureTheme, "miniature theme not found")
+}
+
+// SetMiniatureThemeSlug godoc
+// @Summary Set miniature theme slug
+// @Description Change the slug of a miniature theme. The old slug keeps redirecting.
+// @Tags Miniatures - Themes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Theme ID"
+// @Param slug body models.SlugRequest true "New slug"
+// @Success 200 {object} models.Slugs
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/themes/{id}/slug [put]
+func (h *Handler) SetMiniatureThemeSlug(c *gin.Context) {
+	h.setEntitySlug(c, models.EntityMiniatureTheme, "miniature theme not found")
+}
+
+// GetArticleBySlug godoc
+// @Summary Get article by slug
+// @Description Get a blog article by its current slug. A previous slug redirects (301) to the current one.
+// @Tags Blog - Articles
+// @Produce json
+// @Security BearerAuth
+// @Param slug path string true "Article slug"
+// @Success 200 {object} models.Article
+// @Success 301 "Previous slug; Location is the lookup by the current slug"
+// @Header 301 {string} Location "URL of the lookup by the current slug"
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/slug/{slug} [get]
+func (h *Handler) GetArticleBySlug(c *gin.Context) {
+	h.getBySlug(c, models.EntityArticle, "article not found", func(ctx context.Context, id int64) (interface{}, error) {
+		return h.repo.GetArticleByID(ctx, id)
+	})
+}
+
+// GetArticleSlugs godoc
+// @Summary Get article slugs
+// @Description Get the current slug of a blog article and the slugs it replaced
+// @Tags Blog - Articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Success 200 {object} models.Slugs
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id}/slugs [get]
+func (h *Handler) GetArticleSlugs(c *gin.Context) {
+	h.getEntitySlugs(c, models.EntityArticle, "article not found")
+}
+
+// SetArticleSlug godoc
+// @Summary Set article slug
+// @Description Change the slug of a blog article. The old slug keeps redirecting.
+// @Tags Blog - Articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Param slug body models.SlugRequest true "New slug"
+// @Success 200 {object} models.Slugs
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id}/slug [put]
+func (h *Handler) SetArticleSlug(c *gin.Context) {
+	h.setEntitySlug(c, models.EntityArticle, "article not found")
+}
@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	common "github.com/GunarsK-portfolio/portfolio-common/middleware"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/stream"
+	"github.com/gin-gonic/gin"
+)
+
+// streamRetryMillis tells EventSource clients how long to wait before reconnecting
+const streamRetryMillis = 3000
+
+// StreamEvents godoc
+// @Summary Stream live changes
+// @Description Server-Sent Events stream of content change events. Each message has an id and a JSON
+// @Description event (same format as webhook payloads); only entities the caller can read are sent.
+// @Description Reconnect with the Last-Event-ID header (EventSource does this automatically) to replay
+// @Description missed events. A "reset" event means events were missed and data should be reloaded.
+// @Description Idle streams receive a comment heartbeat; the stream closes when the token expires.
+// @Tags Events
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param Last-Event-ID header string false "ID of the last event received"
+// @Success 200 {string} string "event stream"
+// @Failure 401 {object} map[string]string
+// @Failure 503 {object} problem.Problem
+// @Router /events/stream [get]
+func (h *Handler) StreamEvents(c *gin.Context) {
+	if h.stream == nil {
+		problem.RespondError(c, http.StatusServiceUnavailable, "event stream is not enabled")
+		return
+	}
+
+	lastEventID := c.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = c.Query("lastEventId")
+	}
+	sub, replay, complete := h.stream.Subscribe(lastEventID)
+	defer h.stream.Unsubscribe(sub)
+
+	// Streams outlive the server write timeout; ignore writers that cannot clear it
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+
+	w := c.Writer
+	fmt.Fprintf(w, "retry: %d\n\n", streamRetryMillis)
+	if !complete {
+		fmt.Fprint(w, "event: reset\ndata: {}\n\n")
+	}
+	for _, entry := range replay {
+		writeStreamEntry(c, w, entry)
+	}
+	w.Flush()
+
+	heartbeat := time.NewTicker(h.stream.HeartbeatInterval())
+	defer heartbeat.Stop()
+
+	// End the stream when the token expires so the client reconnects with a fresh one
+	var expired <-chan time.Time
+	if ttl, ok := c.Get(common.CtxKeyTokenTTL); ok {
+		if seconds, ok := ttl.(int64); ok && seconds > 0 {
+			timer := time.NewTimer(time.Duration(seconds) * time.Second)
+			defer timer.Stop()
+			expired = timer.C
+		}
+	}
+
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-expired:
+			return
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+			w.Flush()
+		case entry, ok := <-sub.C:
+			if !ok {
+				// Client fell behind or the server is shutting down; it resumes on reconnect
+				return
+			}
+			if writeStreamEntry(c, w, entry) {
+				w.Flush()
+			}
+		}
+	}
+}
+
+// writeStreamEntry writes an entry the caller may read and reports whether it did
+func writeStreamEntry(c *gin.Context, w io.Writer, entry stream.Entry) bool {
+	if !canRead(c, entry.Event.Entity) {
+		return false
+	}
+	data, err := json.Marshal(entry.Event)
+	if err != nil {
+		return false
+	}
+	fmt.Fprintf(w, "id: %s\ndata: %s\n\n", entry.ID, data)
+	return true
+}
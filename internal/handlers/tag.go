@@ -0,0 +1,381 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/repository"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// listFilter reads the filters shared by list endpoints
+func listFilter(c *gin.Context) models.ListFilter {
+	return models.ListFilter{Tag: strings.TrimSpace(c.Query("tag"))}
+}
+
+// respondTagError maps tag name conflicts to 409 and everything else as a
+// repository error
+func respondTagError(c *gin.Context, err error, notFoundDetail, internalDetail string) {
+	if errors.Is(err, repository.ErrTagExists) {
+		problem.RespondFieldErrors(c, http.StatusConflict, "tag name already exists",
+			problem.FieldError{Field: "name", Message: "is already used by another tag"})
+		return
+	}
+	problem.HandleRepositoryError(c, err, notFoundDetail, internalDetail)
+}
+
+// GetAllTags godoc
+// @Summary Get all tags
+// @Description Get all tags by name with the number of records using each, in total and per entity type
+// @Tags Tags
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.Tag
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /tags [get]
+func (h *Handler) GetAllTags(c *gin.Context) {
+	tags, err := h.repo.GetAllTags(c.Request.Context())
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch tags")
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// GetTagByID godoc
+// @Summary Get tag by ID
+// @Description Get a single tag with its usage counts
+// @Tags Tags
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Tag ID"
+// @Success 200 {object} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /tags/{id} [get]
+func (h *Handler) GetTagByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	tag, err := h.repo.GetTagByID(c.Request.Context(), id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "tag not found", "failed to fetch tag")
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+// CreateTag godoc
+// @Summary Create tag
+// @Description Create a new tag. Names are unique regardless of case.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param tag body models.Tag true "Tag data"
+// @Success 201 {object} models.Tag
+// @Header 201 {string} Location "URL of the created resource"
+// @Failure 400 {object} problem.Problem
+// @Failure 409 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /tags [post]
+func (h *Handler) CreateTag(c *gin.Context) {
+	var tag models.Tag
+	if !bindValid(c, &tag, validation.Tag) {
+		return
+	}
+
+	tag.Name = strings.TrimSpace(tag.Name)
+	if err := h.repo.CreateTag(c.Request.Context(), &tag); err != nil {
+		respondTagError(c, err, "", "failed to create tag")
+		return
+	}
+
+	setLocationHeader(c, tag.ID)
+	h.emit(c, models.EntityTag, events.ActionCreated, tag.ID)
+	c.JSON(http.StatusCreated, tag)
+}
+
+// UpdateTag godoc
+// @Summary Update tag
+// @Description Rename or describe a tag. Tagged records keep the tag.
+// @Tags Tags
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Tag ID"
+// @Param tag body models.Tag true "Tag data"
+// @Success 200 {object} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /tags/{id} [put]
+func (h *Handler) UpdateTag(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var tag models.Tag
+	if !bindValid(c, &tag, validation.Tag) {
+		return
+	}
+
+	tag.ID = id
+	tag.Name = strings.TrimSpace(tag.Name)
+	if err := h.repo.UpdateTag(c.Request.Context(), &tag); err != nil {
+		respondTagError(c, err, "tag not found", "failed to update tag")
+		return
+	}
+
+	h.emit(c, models.EntityTag, events.ActionUpdated, id)
+	c.JSON(http.StatusOK, tag)
+}
+
+// DeleteTag godoc
+// @Summary Delete tag
+// @Description Delete a tag and remove it from every record
+// @Tags Tags
+// @Security BearerAuth
+// @Param id path int true "Tag ID"
+// @Success 204
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /tags/{id} [delete]
+func (h *Handler) DeleteTag(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.repo.DeleteTag(c.Request.Context(), id); err != nil {
+		problem.HandleRepositoryError(c, err, "tag not found", "failed to delete tag")
+		return
+	}
+
+	h.emit(c, models.EntityTag, events.ActionDeleted, id)
+	c.Status(http.StatusNoContent)
+}
+
+// getEntityTags responds with the tags of the record addressed by :id
+func (h *Handler) getEntityTags(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	tags, err := h.repo.GetEntityTags(c.Request.Context(), entity, id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to fetch tags")
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// setEntityTags replaces the tags of the record addressed by :id and responds
+// with the new tags
+func (h *Handler) setEntityTags(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req struct {
+		TagIDs []int64 `json:"tagIds" binding:"max=50,dive,gt=0"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.RespondBindingError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.repo.SetEntityTags(ctx, entity, id, req.TagIDs); err != nil {
+		if errors.Is(err, repository.ErrUnknownTags) {
+			problem.RespondFieldErrors(c, http.StatusUnprocessableEntity, err.Error(),
+				problem.FieldError{Field: "tagIds", Message: "must be existing tag IDs"})
+			return
+		}
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to set tags")
+		return
+	}
+
+	h.emit(c, entity, events.ActionUpdated, id)
+
+	tags, err := h.repo.GetEntityTags(ctx, entity, id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to fetch tags")
+		return
+	}
+	c.JSON(http.StatusOK, tags)
+}
+
+// GetPortfolioProjectTags godoc
+// @Summary Get portfolio project tags
+// @Description Get the tags of a portfolio project by name
+// @Tags Portfolio - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/tags [get]
+func (h *Handler) GetPortfolioProjectTags(c *gin.Context) {
+	h.getEntityTags(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// SetPortfolioProjectTags godoc
+// @Summary Set portfolio project tags
+// @Description Replace all tags of a portfolio project; an empty list removes them
+// @Tags Portfolio - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param tags body object{tagIds=[]int64} true "List of tag IDs"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/tags [put]
+func (h *Handler) SetPortfolioProjectTags(c *gin.Context) {
+	h.setEntityTags(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// GetMiniatureProjectTags godoc
+// @Summary Get miniature project tags
+// @Description Get the tags of a miniature project by name
+// @Tags Miniatures - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/tags [get]
+func (h *Handler) GetMiniatureProjectTags(c *gin.Context) {
+	h.getEntityTags(c, models.EntityMiniatureProject, "project not found")
+}
+
+// SetMiniatureProjectTags godoc
+// @Summary Set miniature project tags
+// @Description Replace all tags of a miniature project; an empty list removes them
+// @Tags Miniatures - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Param tags body object{tagIds=[]int64} true "List of tag IDs"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/tags [put]
+func (h *Handler) SetMiniatureProjectTags(c *gin.Context) {
+	h.setEntityTags(c, models.EntityMiniatureProject, "project not found")
+}
+
+// GetMiniatureThemeTags godoc
+// @Summary Get miniature theme tags
+// @Description Get the tags of a miniature theme by name
+// @Tags Miniatures - Themes
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Theme ID"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/themes/{id}/tags [get]
+func (h *Handler) GetMiniatureThemeTags(c *gin.Context) {
+	h.getEntityTags(c, models.EntityMiniatureTheme, "miniature theme not found")
+}
+
+// SetMiniatureThemeTags godoc
+// @Summary Set miniature theme tags
+// @Description Replace all tags of a miniature theme; an empty list removes them
+// @Tags Miniatures - Themes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Theme ID"
+// @Param tags body object{tagIds=[]int64} true "List of tag IDs"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/themes/{id}/tags [put]
+func (h *Handler) SetMiniatureThemeTags(c *gin.Context) {
+	h.setEntityTags(c, models.EntityMiniatureTheme, "miniature theme not found")
+}
+
+// GetArticleTags godoc
+// @Summary Get article tags
+// @Description Get the tags of a blog article by name
+// @Tags Blog - Articles
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id}/tags [get]
+func (h *Handler) GetArticleTags(c *gin.Context) {
+	h.getEntityTags(c, models.EntityArticle, "article not found")
+}
+
+// SetArticleTags godoc
+// @Summary Set article tags
+// @Description Replace all tags of a blog article; an empty list removes them
+// @Tags Blog - Articles
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Article ID"
+// @Param tags body object{tagIds=[]int64} true "List of tag IDs"
+// @Success 200 {array} models.Tag
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /blog/articles/{id}/tags [put]
+func (h *Handler) SetArticleTags(c *gin.Context) {
+	h.setEntityTags(c, models.EntityArticle, "article not found")
+}
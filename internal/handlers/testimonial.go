@@ -0,0 +1,232 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// GetAllTestimonials godoc
+// @Summary Get all testimonials
+// @Description Get all testimonials ordered by display order, then newest first
+// @Tags Portfolio - Testimonials
+// @Produce json
+// @Security BearerAuth
+// @Param approved query bool false "Only approved (true) or unapproved (false) testimonials"
+// @Param workExperienceId query int false "Only testimonials linked to this work experience"
+// @Param portfolioProjectId query int false "Only testimonials linked to this portfolio project"
+// @Success 200 {array} models.Testimonial
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /portfolio/testimonials [get]
+func (h *Handler) GetAllTestimonials(c *gin.Context) {
+	var filter models.TestimonialFilter
+	if raw := c.Query("approved"); raw != "" {
+		approved, err := strconv.ParseBool(raw)
+		if err != nil {
+			problem.RespondError(c, http.StatusBadRequest, "approved must be true or false")
+			return
+		}
+		filter.Approved = &approved
+	}
+	for _, param := range []struct {
+		name   string
+		target **int64
+	}{
+		{"workExperienceId", &filter.WorkExperienceID},
+		{"portfolioProjectId", &filter.PortfolioProjectID},
+	} {
+		raw := c.Query(param.name)
+		if raw == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || id < 1 {
+			problem.RespondError(c, http.StatusBadRequest, "invalid "+param.name)
+			return
+		}
+		*param.target = &id
+	}
+
+	testimonials, err := h.repo.GetAllTestimonials(c.Request.Context(), filter)
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch testimonials")
+		return
+	}
+
+	c.JSON(http.StatusOK, testimonials)
+}
+
+// GetTestimonialByID godoc
+// @Summary Get testimonial by ID
+// @Description Get a single testimonial by ID
+// @Tags Portfolio - Testimonials
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Testimonial ID"
+// @Success 200 {object} models.Testimonial
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/testimonials/{id} [get]
+func (h *Handler) GetTestimonialByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	testimonial, err := h.repo.GetTestimonialByID(c.Request.Context(), id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "testimonial not found", "failed to fetch testimonial")
+		return
+	}
+
+	c.JSON(http.StatusOK, testimonial)
+}
+
+// CreateTestimonial godoc
+// @Summary Create testimonial
+// @Description Create a new testimonial. Link it to at most one of a work experience entry or a portfolio project.
+// @Description New testimonials are unapproved unless approved is set.
+// @Tags Portfolio - Testimonials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param testimonial body models.Testimonial true "Testimonial data"
+// @Success 201 {object} models.Testimonial
+// @Header 201 {string} Location "URL of the created resource"
+// @Failure 400 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/testimonials [post]
+func (h *Handler) CreateTestimonial(c *gin.Context) {
+	var testimonial models.Testimonial
+	if !bindValid(c, &testimonial, validation.Testimonial) {
+		return
+	}
+	testimonial.AvatarFile = nil // read-only, populated on reads
+	if !h.validFileRefs(c, fileRef{"avatarFileId", filerefs.UsageAvatar, testimonial.AvatarFileID}) ||
+		!h.validTestimonialLink(c, &testimonial) {
+		return
+	}
+
+	if err := h.repo.CreateTestimonial(c.Request.Context(), &testimonial); err != nil {
+		problem.HandleRepositoryError(c, err, "", "failed to create testimonial")
+		return
+	}
+
+	setLocationHeader(c, testimonial.ID)
+	h.emit(c, models.EntityTestimonial, events.ActionCreated, testimonial.ID)
+	c.JSON(http.StatusCreated, testimonial)
+}
+
+// UpdateTestimonial godoc
+// @Summary Update testimonial
+// @Description Update an existing testimonial
+// @Tags Portfolio - Testimonials
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Testimonial ID"
+// @Param testimonial body models.Testimonial true "Testimonial data"
+// @Success 200 {object} models.Testimonial
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/testimonials/{id} [put]
+func (h *Handler) UpdateTestimonial(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var testimonial models.Testimonial
+	if !bindValid(c, &testimonial, validation.Testimonial) {
+		return
+	}
+	testimonial.AvatarFile = nil // read-only, populated on reads
+	if !h.validFileRefs(c, fileRef{"avatarFileId", filerefs.UsageAvatar, testimonial.AvatarFileID}) ||
+		!h.validTestimonialLink(c, &testimonial) {
+		return
+	}
+
+	testimonial.ID = id
+	if err := h.repo.UpdateTestimonial(c.Request.Context(), &testimonial); err != nil {
+		problem.HandleRepositoryError(c, err, "testimonial not found", "failed to update testimonial")
+		return
+	}
+
+	h.emit(c, models.EntityTestimonial, events.ActionUpdated, id)
+	c.JSON(http.StatusOK, testimonial)
+}
+
+// DeleteTestimonial godoc
+// @Summary Delete testimonial
+// @Description Delete a testimonial
+// @Tags Portfolio - Testimonials
+// @Security BearerAuth
+// @Param id path int true "Testimonial ID"
+// @Success 204
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/testimonials/{id} [delete]
+func (h *Handler) DeleteTestimonial(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.repo.DeleteTestimonial(c.Request.Context(), id); err != nil {
+		respondDeleteError(c, err, "testimonial not found", "failed to delete testimonial")
+		return
+	}
+
+	h.emit(c, models.EntityTestimonial, events.ActionDeleted, id)
+	c.Status(http.StatusNoContent)
+}
+
+// validTestimonialLink checks that the work experience or portfolio project a
+// testimonial is linked to exists. It responds 422 for a missing record and
+// reports whether the link is usable.
+func (h *Handler) validTestimonialLink(c *gin.Context, testimonial *models.Testimonial) bool {
+	var field, kind string
+	var id int64
+	var err error
+	switch {
+	case testimonial.WorkExperienceID != nil:
+		field, kind, id = "workExperienceId", "work experience", *testimonial.WorkExperienceID
+		_, err = h.repo.GetWorkExperienceByID(c.Request.Context(), id)
+	case testimonial.PortfolioProjectID != nil:
+		field, kind, id = "portfolioProjectId", "portfolio project", *testimonial.PortfolioProjectID
+		_, err = h.repo.GetPortfolioProjectByID(c.Request.Context(), id)
+	default:
+		return true
+	}
+	if err == nil {
+		return true
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		problem.RespondFieldErrors(c, http.StatusUnprocessableEntity, fmt.Sprintf("%s %d: %s does not exist", field, id, kind),
+			problem.FieldError{Field: field, Message: kind + " does not exist"})
+		return false
+	}
+	problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to check "+field)
+	return false
+}
@@ -0,0 +1,308 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/translation"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+)
+
+// localize translates records into the locale negotiated from
+// Accept-Language and sets Content-Language. records maps record IDs to
+// pointers to the records; fields without a translation keep their text.
+func (h *Handler) localize(c *gin.Context, entity string, records map[int64]interface{}) error {
+	c.Header("Vary", "Accept-Language")
+	locale := translation.Negotiate(c.GetHeader("Accept-Language"), h.locales)
+	c.Header("Content-Language", locale)
+	if locale == h.locales[0] || len(records) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	values, err := h.repo.GetTranslationValues(c.Request.Context(), entity, locale, ids)
+	if err != nil {
+		return err
+	}
+	for id, record := range records {
+		translation.Apply(record, values[id])
+	}
+	return nil
+}
+
+// translationLocale returns the :locale parameter, responding with 400 when
+// it is not a locale translations are kept for
+func (h *Handler) translationLocale(c *gin.Context) (string, bool) {
+	locale := strings.ToLower(c.Param("locale"))
+	if !slices.Contains(h.locales[1:], locale) {
+		problem.RespondError(c, http.StatusBadRequest, fmt.Sprintf("locale %q has no translations; translation locales: %s",
+			c.Param("locale"), strings.Join(h.locales[1:], ", ")))
+		return "", false
+	}
+	return locale, true
+}
+
+// getTranslations responds with the translations of the record addressed by :id
+func (h *Handler) getTranslations(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+	h.respondTranslations(c, entity, id, notFoundDetail)
+}
+
+// setTranslations replaces the translations of the record addressed by :id in
+// the :locale language
+func (h *Handler) setTranslations(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+	h.saveTranslations(c, entity, id, notFoundDetail)
+}
+
+// respondTranslations responds with the translations of one record
+func (h *Handler) respondTranslations(c *gin.Context, entity string, id int64, notFoundDetail string) {
+	translations, err := h.repo.GetTranslations(c.Request.Context(), entity, id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to fetch translations")
+		return
+	}
+
+	c.JSON(http.StatusOK, translations)
+}
+
+// saveTranslations binds and stores the translations of one record in the
+// :locale language and responds with all its translations
+func (h *Handler) saveTranslations(c *gin.Context, entity string, id int64, notFoundDetail string) {
+	locale, ok := h.translationLocale(c)
+	if !ok {
+		return
+	}
+
+	var req models.TranslationRequest
+	if !bindValid(c, &req, validation.TranslationRequest(entity)) {
+		return
+	}
+
+	if err := h.repo.SetTranslations(c.Request.Context(), entity, id, locale, translation.Encode(entity, req.Fields)); err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to save translations")
+		return
+	}
+
+	h.emit(c, entity, events.ActionUpdated, id)
+	h.respondTranslations(c, entity, id, notFoundDetail)
+}
+
+// GetMissingTranslations godoc
+// @Summary Get missing translations
+// @Description List, per record and translation locale, the fields that have text but no translation. Only entities the caller can read are included.
+// @Tags Translations
+// @Produce json
+// @Security BearerAuth
+// @Param locale query string false "Only this translation locale"
+// @Success 200 {object} models.MissingTranslationsReport
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /translations/missing [get]
+func (h *Handler) GetMissingTranslations(c *gin.Context) {
+	locales := h.locales[1:]
+	if locale := strings.ToLower(strings.TrimSpace(c.Query("locale"))); locale != "" {
+		if !slices.Contains(locales, locale) {
+			problem.RespondFieldErrors(c, http.StatusBadRequest, "request validation failed",
+				problem.FieldError{Field: "locale", Message: "must be one of " + strings.Join(locales, ", ")})
+			return
+		}
+		locales = []string{locale}
+	}
+
+	report := models.MissingTranslationsReport{Locales: locales, Items: []models.MissingTranslation{}}
+	if len(locales) == 0 {
+		c.JSON(http.StatusOK, report)
+		return
+	}
+	for _, entity := range translation.Entities {
+		if !canRead(c, entity) {
+			continue
+		}
+		items, err := h.repo.GetMissingTranslations(c.Request.Context(), entity, locales)
+		if err != nil {
+			problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to check translations")
+			return
+		}
+		for _, item := range items {
+			item.Path = entityPath(entity, item.EntityID)
+			report.Items = append(report.Items, item)
+		}
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// GetProfileTranslations godoc
+// @Summary Get profile translations
+// @Description Get the translated title and tagline of the profile by locale
+// @Tags Portfolio - Profile
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} models.Translations
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/profile/translations [get]
+func (h *Handler) GetProfileTranslations(c *gin.Context) {
+	profile, err := h.repo.GetProfile(c.Request.Context())
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "profile not found", "failed to fetch profile")
+		return
+	}
+	h.respondTranslations(c, models.EntityProfile, profile.ID, "profile not found")
+}
+
+// SetProfileTranslations godoc
+// @Summary Set profile translations
+// @Description Replace the translations of the profile in one locale. Translatable fields: title, tagline.
+// @Tags Portfolio - Profile
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param locale path string true "Translation locale" example(lv)
+// @Param translations body models.TranslationRequest true "Translated fields"
+// @Success 200 {object} models.Translations
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/profile/translations/{locale} [put]
+func (h *Handler) SetProfileTranslations(c *gin.Context) {
+	profile, err := h.repo.GetProfile(c.Request.Context())
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "profile not found", "failed to fetch profile")
+		return
+	}
+	h.saveTranslations(c, models.EntityProfile, profile.ID, "profile not found")
+}
+
+// GetWorkExperienceTranslations godoc
+// @Summary Get work experience translations
+// @Description Get the translated description of a work experience entry by locale
+// @Tags Portfolio - Experience
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Work Experience ID"
+// @Success 200 {object} models.Translations
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/experience/{id}/translations [get]
+func (h *Handler) GetWorkExperienceTranslations(c *gin.Context) {
+	h.getTranslations(c, models.EntityWorkExperience, "work experience not found")
+}
+
+// SetWorkExperienceTranslations godoc
+// @Summary Set work experience translations
+// @Description Replace the translations of a work experience entry in one locale. Translatable fields: description.
+// @Tags Portfolio - Experience
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Work Experience ID"
+// @Param locale path string true "Translation locale" example(lv)
+// @Param translations body models.TranslationRequest true "Translated fields"
+// @Success 200 {object} models.Translations
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/experience/{id}/translations/{locale} [put]
+func (h *Handler) SetWorkExperienceTranslations(c *gin.Context) {
+	h.setTranslations(c, models.EntityWorkExperience, "work experience not found")
+}
+
+// GetPortfolioProjectTranslations godoc
+// @Summary Get portfolio project translations
+// @Description Get the translated fields of a portfolio project by locale
+// @Tags Portfolio - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Portfolio Project ID"
+// @Success 200 {object} models.Translations
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/translations [get]
+func (h *Handler) GetPortfolioProjectTranslations(c *gin.Context) {
+	h.getTranslations(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// SetPortfolioProjectTranslations godoc
+// @Summary Set portfolio project translations
+// @Description Replace the translations of a portfolio project in one locale. Translatable fields: description, longDescription, challenges and learnings (lists). longDescription is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.
+// @Tags Portfolio - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Portfolio Project ID"
+// @Param locale path string true "Translation locale" example(lv)
+// @Param translations body models.TranslationRequest true "Translated fields"
+// @Success 200 {object} models.Translations
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/translations/{locale} [put]
+func (h *Handler) SetPortfolioProjectTranslations(c *gin.Context) {
+	h.setTranslations(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// GetMiniatureProjectTranslations godoc
+// @Summary Get miniature project translations
+// @Description Get the translated description of a miniature project by locale
+// @Tags Miniatures - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Success 200 {object} models.Translations
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/translations [get]
+func (h *Handler) GetMiniatureProjectTranslations(c *gin.Context) {
+	h.getTranslations(c, models.EntityMiniatureProject, "miniature project not found")
+}
+
+// SetMiniatureProjectTranslations godoc
+// @Summary Set miniature project translations
+// @Description Replace the translations of a miniature project in one locale. Translatable fields: description. description is markdown; unsafe HTML and links are not stripped, the request is rejected with 400.
+// @Tags Miniatures - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Param locale path string true "Translation locale" example(lv)
+// @Param translations body models.TranslationRequest true "Translated fields"
+// @Success 200 {object} models.Translations
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/translations/{locale} [put]
+func (h *Handler) SetMiniatureProjectTranslations(c *gin.Context) {
+	h.setTranslations(c, models.EntityMiniatureProject, "miniature project not found")
+}
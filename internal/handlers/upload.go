@@ -0,0 +1,264 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/GunarsK-portfolio/portfolio-common/logger"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/filerefs"
+	"github.com/GunarsK-portfolio/admin-api/internal/filesapi"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+// maxUploadFieldBytes caps non-file form fields such as caption
+const maxUploadFieldBytes = 1 << 10
+
+// UploadProjectImage godoc
+// @Summary Upload image to miniature project
+// @Description Stream an image to files-api and link it to a miniature project in one request.
+// @Description Form fields (caption) must come before the file part. If linking fails the
+// @Description uploaded file is deleted again.
+// @Tags Miniatures - Projects
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Param caption formData string false "Image caption"
+// @Param file formData file true "Image file"
+// @Success 201 {object} models.MiniatureFile
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} problem.Problem
+// @Failure 413 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 502 {object} problem.Problem
+// @Failure 503 {object} problem.Problem
+// @Router /miniatures/projects/{id}/images/upload [post]
+func (h *Handler) UploadProjectImage(c *gin.Context) {
+	projectID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid project id")
+		return
+	}
+
+	file, fields, ok := h.receiveUpload(c, filerefs.UsageGalleryImage)
+	if !ok {
+		return
+	}
+
+	miniatureFile := &models.MiniatureFile{
+		MiniatureProjectID: projectID,
+		FileID:             file.ID,
+		Caption:            fields["caption"],
+	}
+	if err := h.repo.AddImageToProject(c.Request.Context(), miniatureFile); err != nil {
+		h.discardUpload(c, file.ID)
+		problem.HandleRepositoryError(c, err, "project not found", "failed to add image to project")
+		return
+	}
+
+	miniatureFile.File = file
+	h.emit(c, models.EntityMiniatureProject, events.ActionUpdated, projectID)
+	c.JSON(http.StatusCreated, miniatureFile)
+}
+
+// UploadProfileAvatar godoc
+// @Summary Upload profile avatar
+// @Description Stream an image to files-api and set it as the profile avatar in one request.
+// @Description If linking fails the uploaded file is deleted again.
+// @Tags Portfolio - Profile
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Avatar image"
+// @Success 200 {object} models.StorageFile
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 413 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 502 {object} problem.Problem
+// @Failure 503 {object} problem.Problem
+// @Router /portfolio/profile/avatar/upload [post]
+func (h *Handler) UploadProfileAvatar(c *gin.Context) {
+	file, _, ok := h.receiveUpload(c, filerefs.UsageAvatar)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.UpdateProfileAvatar(c.Request.Context(), file.ID); err != nil {
+		h.discardUpload(c, file.ID)
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to update avatar")
+		return
+	}
+
+	h.emit(c, models.EntityProfile, events.ActionUpdated, 0)
+	c.JSON(http.StatusOK, file)
+}
+
+// UploadProfileResume godoc
+// @Summary Upload profile resume
+// @Description Stream a PDF to files-api and set it as the profile resume in one request.
+// @Description If linking fails the uploaded file is deleted again.
+// @Tags Portfolio - Profile
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "Resume PDF"
+// @Success 200 {object} models.StorageFile
+// @Failure 400 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 413 {object} problem.Problem
+// @Failure 422 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 502 {object} problem.Problem
+// @Failure 503 {object} problem.Problem
+// @Router /portfolio/profile/resume/upload [post]
+func (h *Handler) UploadProfileResume(c *gin.Context) {
+	file, _, ok := h.receiveUpload(c, filerefs.UsageResume)
+	if !ok {
+		return
+	}
+
+	if err := h.repo.UpdateProfileResume(c.Request.Context(), file.ID); err != nil {
+		h.discardUpload(c, file.ID)
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to update resume")
+		return
+	}
+
+	h.emit(c, models.EntityProfile, events.ActionUpdated, 0)
+	c.JSON(http.StatusOK, file)
+}
+
+// receiveUpload streams the "file" part of a multipart request to files-api and
+// checks the stored file suits usage. Form fields before the file part are
+// returned. On failure it responds and reports false; nothing is left in files-api.
+func (h *Handler) receiveUpload(c *gin.Context, usage filerefs.Usage) (*models.StorageFile, map[string]string, bool) {
+	if h.files == nil {
+		problem.RespondError(c, http.StatusServiceUnavailable, "file uploads are not enabled")
+		return nil, nil, false
+	}
+
+	// Uploads may outlast the server read/write timeouts; writers that cannot extend them keep the defaults
+	rc := http.NewResponseController(c.Writer)
+	deadline := time.Now().Add(h.uploadTimeout)
+	_ = rc.SetReadDeadline(deadline)
+	_ = rc.SetWriteDeadline(deadline)
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadBytes)
+	form, err := c.Request.MultipartReader()
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "expected a multipart/form-data request")
+		return nil, nil, false
+	}
+
+	fields := make(map[string]string)
+	for {
+		part, err := form.NextPart()
+		if errors.Is(err, io.EOF) {
+			problem.RespondError(c, http.StatusBadRequest, "file is required")
+			return nil, nil, false
+		}
+		if err != nil {
+			respondUploadReadError(c, err)
+			return nil, nil, false
+		}
+
+		if part.FormName() != "file" {
+			value, err := io.ReadAll(io.LimitReader(part, maxUploadFieldBytes+1))
+			if err != nil {
+				respondUploadReadError(c, err)
+				return nil, nil, false
+			}
+			if len(value) > maxUploadFieldBytes {
+				problem.RespondFieldErrors(c, http.StatusBadRequest, "request validation failed", problem.FieldError{
+					Field:   part.FormName(),
+					Message: fmt.Sprintf("must be at most %d bytes long", maxUploadFieldBytes),
+				})
+				return nil, nil, false
+			}
+			fields[part.FormName()] = string(value)
+			continue
+		}
+
+		// Reject obviously wrong files before sending anything to files-api
+		contentType := part.Header.Get("Content-Type")
+		if reason := filerefs.Check(&models.StorageFile{MimeType: contentType}, usage); reason != "" {
+			problem.RespondFieldErrors(c, http.StatusUnprocessableEntity, "file: "+reason, problem.FieldError{Field: "file", Message: reason})
+			return nil, nil, false
+		}
+
+		body := &readErrRecorder{r: part}
+		ctx := filesapi.WithToken(c.Request.Context(), accessToken(c))
+		file, err := h.files.Upload(ctx, filesapi.Upload{
+			FileName:    part.FileName(),
+			ContentType: contentType,
+			FileType:    filerefs.FileType(usage),
+			Body:        body,
+		})
+		if err != nil {
+			if body.err != nil {
+				respondUploadReadError(c, body.err)
+				return nil, nil, false
+			}
+			problem.LogAndRespondError(c, http.StatusBadGateway, err, "failed to store file in files-api")
+			return nil, nil, false
+		}
+
+		// files-api detects the stored type; trust it over the declared one
+		if reason := filerefs.Check(file, usage); reason != "" {
+			h.discardUpload(c, file.ID)
+			problem.RespondFieldErrors(c, http.StatusUnprocessableEntity, "file: "+reason, problem.FieldError{Field: "file", Message: reason})
+			return nil, nil, false
+		}
+		return file, fields, true
+	}
+}
+
+// discardUpload deletes a file uploaded earlier in this request because it
+// could not be linked. It runs even if the client has gone away; a failure
+// leaves an orphan for POST /files/orphans/purge.
+func (h *Handler) discardUpload(c *gin.Context, fileID int64) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(c.Request.Context()), h.uploadTimeout)
+	defer cancel()
+	ctx = filesapi.WithToken(ctx, accessToken(c))
+	if err := h.files.DeleteFile(ctx, fileID); err != nil {
+		logger.GetLogger(c).Error("Failed to delete unlinked upload", "file_id", fileID, "error", err)
+	}
+}
+
+// respondUploadReadError maps errors reading the request body
+func respondUploadReadError(c *gin.Context, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		problem.RespondError(c, http.StatusRequestEntityTooLarge,
+			fmt.Sprintf("upload exceeds the %d byte limit", tooLarge.Limit))
+		return
+	}
+	problem.RespondError(c, http.StatusBadRequest, "failed to read upload: "+err.Error())
+}
+
+// readErrRecorder remembers the first error reading the client's upload, so a
+// failed upload can be blamed on the client rather than files-api
+type readErrRecorder struct {
+	r   io.Reader
+	err error
+}
+
+func (r *readErrRecorder) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
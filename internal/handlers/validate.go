@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// bindValid binds the JSON body into obj and checks it with the domain rules.
+// Binding tag and domain rule violations are reported together in one 400
+// response; it reports whether obj is valid.
+func bindValid[T any](c *gin.Context, obj *T, rules func(*T) validation.Errors) bool {
+	var errs validation.Errors
+	if err := c.ShouldBindJSON(obj); err != nil {
+		// Only tag violations leave a fully decoded body worth checking further
+		var tagErrs validator.ValidationErrors
+		if !errors.As(err, &tagErrs) {
+			problem.RespondBindingError(c, err)
+			return false
+		}
+		_, fields := problem.DescribeBindingError(err)
+		errs.Merge(fields)
+	}
+	errs.Merge(rules(obj))
+
+	if len(errs) > 0 {
+		problem.RespondFieldErrors(c, http.StatusBadRequest, "request validation failed", errs...)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/gin-gonic/gin"
+)
+
+// getEntityVisibility responds with the visibility of the record addressed by :id
+func (h *Handler) getEntityVisibility(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	visibility, err := h.repo.GetEntityVisibility(c.Request.Context(), entity, id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to fetch visibility")
+		return
+	}
+
+	c.JSON(http.StatusOK, visibility)
+}
+
+// setEntityVisibility shows or hides the record addressed by :id on the public
+// site and responds with its visibility
+func (h *Handler) setEntityVisibility(c *gin.Context, entity, notFoundDetail string) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var req models.Visibility
+	if err := c.ShouldBindJSON(&req); err != nil {
+		problem.RespondBindingError(c, err)
+		return
+	}
+
+	if err := h.repo.SetEntityVisibility(c.Request.Context(), entity, id, *req.Visible); err != nil {
+		problem.HandleRepositoryError(c, err, notFoundDetail, "failed to set visibility")
+		return
+	}
+
+	h.emit(c, entity, events.ActionUpdated, id)
+	c.JSON(http.StatusOK, req)
+}
+
+// GetPortfolioProjectVisibility godoc
+// @Summary Get portfolio project visibility
+// @Description Get whether a portfolio project is shown on the public site. Clones start hidden.
+// @Tags Portfolio - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Success 200 {object} models.Visibility
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/visibility [get]
+func (h *Handler) GetPortfolioProjectVisibility(c *gin.Context) {
+	h.getEntityVisibility(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// SetPortfolioProjectVisibility godoc
+// @Summary Set portfolio project visibility
+// @Description Show or hide a portfolio project on the public site
+// @Tags Portfolio - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Project ID"
+// @Param visibility body models.Visibility true "Visibility"
+// @Success 200 {object} models.Visibility
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/projects/{id}/visibility [put]
+func (h *Handler) SetPortfolioProjectVisibility(c *gin.Context) {
+	h.setEntityVisibility(c, models.EntityPortfolioProject, "portfolio project not found")
+}
+
+// GetMiniatureProjectVisibility godoc
+// @Summary Get miniature project visibility
+// @Description Get whether a miniature project is shown on the public site. Clones start hidden.
+// @Tags Miniatures - Projects
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Success 200 {object} models.Visibility
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/visibility [get]
+func (h *Handler) GetMiniatureProjectVisibility(c *gin.Context) {
+	h.getEntityVisibility(c, models.EntityMiniatureProject, "project not found")
+}
+
+// SetMiniatureProjectVisibility godoc
+// @Summary Set miniature project visibility
+// @Description Show or hide a miniature project on the public site
+// @Tags Miniatures - Projects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Miniature Project ID"
+// @Param visibility body models.Visibility true "Visibility"
+// @Success 200 {object} models.Visibility
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /miniatures/projects/{id}/visibility [put]
+func (h *Handler) SetMiniatureProjectVisibility(c *gin.Context) {
+	h.setEntityVisibility(c, models.EntityMiniatureProject, "project not found")
+}
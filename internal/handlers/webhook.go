@@ -0,0 +1,260 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 200
+)
+
+// GetAllWebhooks godoc
+// @Summary Get all webhooks
+// @Description Get all registered outbound webhooks (secrets are never returned)
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} models.Webhook
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /webhooks [get]
+func (h *Handler) GetAllWebhooks(c *gin.Context) {
+	hooks, err := h.repo.GetAllWebhooks(c.Request.Context())
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch webhooks")
+		return
+	}
+
+	for i := range hooks {
+		hooks[i].Secret = ""
+	}
+	c.JSON(http.StatusOK, hooks)
+}
+
+// GetWebhookByID godoc
+// @Summary Get webhook by ID
+// @Description Get a single webhook by ID (the secret is never returned)
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} models.Webhook
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /webhooks/{id} [get]
+func (h *Handler) GetWebhookByID(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	webhook, err := h.repo.GetWebhookByID(c.Request.Context(), id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "webhook not found", "failed to fetch webhook")
+		return
+	}
+
+	webhook.Secret = ""
+	c.JSON(http.StatusOK, webhook)
+}
+
+// CreateWebhook godoc
+// @Summary Create webhook
+// @Description Register an outbound webhook. events lists event names (portfolio.project.updated),
+// @Description prefix wildcards (miniatures.*) or * for all events. The secret (min 16 characters)
+// @Description signs every payload with HMAC-SHA256 and is never returned.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param webhook body models.Webhook true "Webhook data"
+// @Success 201 {object} models.Webhook
+// @Header 201 {string} Location "URL of the created resource"
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /webhooks [post]
+func (h *Handler) CreateWebhook(c *gin.Context) {
+	var webhook models.Webhook
+	if !bindValid(c, &webhook, validateNewWebhook) {
+		return
+	}
+
+	if err := h.repo.CreateWebhook(c.Request.Context(), &webhook); err != nil {
+		problem.HandleRepositoryError(c, err, "", "failed to create webhook")
+		return
+	}
+
+	webhook.Secret = ""
+	setLocationHeader(c, webhook.ID)
+	c.JSON(http.StatusCreated, webhook)
+}
+
+// UpdateWebhook godoc
+// @Summary Update webhook
+// @Description Update an existing webhook. Omit secret to keep the current one.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Param webhook body models.Webhook true "Webhook data"
+// @Success 200 {object} models.Webhook
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Router /webhooks/{id} [put]
+func (h *Handler) UpdateWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	var webhook models.Webhook
+	if !bindValid(c, &webhook, validation.Webhook) {
+		return
+	}
+
+	webhook.ID = id
+	if err := h.repo.UpdateWebhook(c.Request.Context(), &webhook); err != nil {
+		problem.HandleRepositoryError(c, err, "webhook not found", "failed to update webhook")
+		return
+	}
+
+	webhook.Secret = ""
+	c.JSON(http.StatusOK, webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete webhook
+// @Description Delete a webhook and its delivery log
+// @Tags Webhooks
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Success 204
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
+// @Failure 401 {object} map[string]string
+// @Router /webhooks/{id} [delete]
+func (h *Handler) DeleteWebhook(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if err := h.repo.DeleteWebhook(c.Request.Context(), id); err != nil {
+		respondDeleteError(c, err, "webhook not found", "failed to delete webhook")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// GetWebhookDeliveries godoc
+// @Summary Get webhook deliveries
+// @Description Get the most recent deliveries of a webhook with status, attempts and last error
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Param limit query int false "Maximum number of deliveries (1-200, default 50)"
+// @Success 200 {array} models.WebhookDelivery
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Router /webhooks/{id}/deliveries [get]
+func (h *Handler) GetWebhookDeliveries(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	limit := defaultDeliveriesLimit
+	if raw := c.Query("limit"); raw != "" {
+		parsed, err := strconv.Atoi(raw)
+		if err != nil || parsed < 1 || parsed > maxDeliveriesLimit {
+			problem.RespondError(c, http.StatusBadRequest, "limit must be between 1 and 200")
+			return
+		}
+		limit = parsed
+	}
+
+	if _, err := h.repo.GetWebhookByID(c.Request.Context(), id); err != nil {
+		problem.HandleRepositoryError(c, err, "webhook not found", "failed to fetch webhook")
+		return
+	}
+
+	deliveries, err := h.repo.GetWebhookDeliveries(c.Request.Context(), id, limit)
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch webhook deliveries")
+		return
+	}
+
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// SendWebhookTestEvent godoc
+// @Summary Send test event
+// @Description Synchronously deliver a webhook.test event to the webhook (single attempt, no retries)
+// @Description and return the recorded delivery. A failed delivery is still returned with 200.
+// @Tags Webhooks
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "Webhook ID"
+// @Success 200 {object} models.WebhookDelivery
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} problem.Problem
+// @Failure 503 {object} problem.Problem
+// @Router /webhooks/{id}/test [post]
+func (h *Handler) SendWebhookTestEvent(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
+		return
+	}
+
+	if h.webhookTester == nil {
+		problem.RespondError(c, http.StatusServiceUnavailable, "webhook delivery is not enabled")
+		return
+	}
+
+	webhook, err := h.repo.GetWebhookByID(c.Request.Context(), id)
+	if err != nil {
+		problem.HandleRepositoryError(c, err, "webhook not found", "failed to fetch webhook")
+		return
+	}
+
+	delivery, err := h.webhookTester.SendTest(c.Request.Context(), webhook)
+	if err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to send test event")
+		return
+	}
+
+	c.JSON(http.StatusOK, delivery)
+}
+
+// validateNewWebhook also requires a secret; updates may omit it to keep the current one
+func validateNewWebhook(webhook *models.Webhook) validation.Errors {
+	errs := validation.Webhook(webhook)
+	if webhook.Secret == "" {
+		errs.Add("secret", "is required")
+	}
+	return errs
+}
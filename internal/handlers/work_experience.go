@@ -4,26 +4,37 @@ import (
 	"net/http"
 	"strconv"
 
-	commonHandlers "github.com/GunarsK-portfolio/portfolio-common/handlers"
-
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+	"github.com/GunarsK-portfolio/admin-api/internal/validation"
 	"github.com/gin-gonic/gin"
 )
 
 // GetAllWorkExperience godoc
 // @Summary Get all work experience
-// @Description Get all work experience entries
+// @Description Get all work experience entries. Descriptions follow Accept-Language.
 // @Tags Portfolio - Experience
 // @Produce json
 // @Security BearerAuth
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
 // @Success 200 {array} models.WorkExperience
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
 // @Failure 401 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 500 {object} problem.Problem
 // @Router /portfolio/experience [get]
 func (h *Handler) GetAllWorkExperience(c *gin.Context) {
 	experiences, err := h.repo.GetAllWorkExperience(c.Request.Context())
 	if err != nil {
-		commonHandlers.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch work experience")
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch work experience")
+		return
+	}
+	records := make(map[int64]interface{}, len(experiences))
+	for i := range experiences {
+		records[experiences[i].ID] = &experiences[i]
+	}
+	if err := h.localize(c, models.EntityWorkExperience, records); err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch translations")
 		return
 	}
 
@@ -32,27 +43,33 @@ func (h *Handler) GetAllWorkExperience(c *gin.Context) {
 
 // GetWorkExperienceByID godoc
 // @Summary Get work experience by ID
-// @Description Get a single work experience entry by ID
+// @Description Get a single work experience entry by ID. The description follows Accept-Language.
 // @Tags Portfolio - Experience
 // @Produce json
 // @Security BearerAuth
 // @Param id path int true "Work Experience ID"
+// @Param Accept-Language header string false "Content language; translation locales replace translated fields" example(lv)
 // @Success 200 {object} models.WorkExperience
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Header 200 {string} Content-Language "Locale of the translatable fields"
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/experience/{id} [get]
 func (h *Handler) GetWorkExperienceByID(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	exp, err := h.repo.GetWorkExperienceByID(c.Request.Context(), id)
 	if err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "work experience not found", "failed to fetch work experience")
+		problem.HandleRepositoryError(c, err, "work experience not found", "failed to fetch work experience")
+		return
+	}
+	if err := h.localize(c, models.EntityWorkExperience, map[int64]interface{}{exp.ID: exp}); err != nil {
+		problem.LogAndRespondError(c, http.StatusInternalServerError, err, "failed to fetch translations")
 		return
 	}
 
@@ -69,23 +86,23 @@ func (h *Handler) GetWorkExperienceByID(c *gin.Context) {
 // @Param experience body models.WorkExperience true "Work experience data"
 // @Success 201 {object} models.WorkExperience
 // @Header 201 {string} Location "URL of the created resource"
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 500 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/experience [post]
 func (h *Handler) CreateWorkExperience(c *gin.Context) {
 	var exp models.WorkExperience
-	if err := c.ShouldBindJSON(&exp); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &exp, validation.WorkExperience) {
 		return
 	}
 
 	if err := h.repo.CreateWorkExperience(c.Request.Context(), &exp); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "", "failed to create work experience")
+		problem.HandleRepositoryError(c, err, "", "failed to create work experience")
 		return
 	}
 
 	setLocationHeader(c, exp.ID)
+	h.emit(c, models.EntityWorkExperience, events.ActionCreated, exp.ID)
 	c.JSON(http.StatusCreated, exp)
 }
 
@@ -99,29 +116,29 @@ func (h *Handler) CreateWorkExperience(c *gin.Context) {
 // @Param id path int true "Work Experience ID"
 // @Param experience body models.WorkExperience true "Work experience data"
 // @Success 200 {object} models.WorkExperience
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/experience/{id} [put]
 func (h *Handler) UpdateWorkExperience(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	var exp models.WorkExperience
-	if err := c.ShouldBindJSON(&exp); err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, err.Error())
+	if !bindValid(c, &exp, validation.WorkExperience) {
 		return
 	}
 
 	exp.ID = id
 	if err := h.repo.UpdateWorkExperience(c.Request.Context(), &exp); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "work experience not found", "failed to update work experience")
+		problem.HandleRepositoryError(c, err, "work experience not found", "failed to update work experience")
 		return
 	}
 
+	h.emit(c, models.EntityWorkExperience, events.ActionUpdated, id)
 	c.JSON(http.StatusOK, exp)
 }
 
@@ -132,21 +149,23 @@ func (h *Handler) UpdateWorkExperience(c *gin.Context) {
 // @Security BearerAuth
 // @Param id path int true "Work Experience ID"
 // @Success 204
-// @Failure 400 {object} map[string]string
-// @Failure 404 {object} map[string]string
+// @Failure 400 {object} problem.Problem
+// @Failure 404 {object} problem.Problem
+// @Failure 409 {object} dependentsProblem
 // @Failure 401 {object} map[string]string
 // @Router /portfolio/experience/{id} [delete]
 func (h *Handler) DeleteWorkExperience(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
-		commonHandlers.RespondError(c, http.StatusBadRequest, "invalid id")
+		problem.RespondError(c, http.StatusBadRequest, "invalid id")
 		return
 	}
 
 	if err := h.repo.DeleteWorkExperience(c.Request.Context(), id); err != nil {
-		commonHandlers.HandleRepositoryError(c, err, "work experience not found", "failed to delete work experience")
+		respondDeleteError(c, err, "work experience not found", "failed to delete work experience")
 		return
 	}
 
+	h.emit(c, models.EntityWorkExperience, events.ActionDeleted, id)
 	c.Status(http.StatusNoContent)
 }
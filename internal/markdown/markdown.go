@@ -0,0 +1,150 @@
+// Package markdown renders the markdown of long descriptions to safe HTML and
+// lists what unsafe content a source contains.
+//
+// Sources are rendered with goldmark (CommonMark plus strikethrough and
+// tables). Raw HTML is passed through and the result is sanitized with
+// bluemonday's UGC policy, so raw HTML in a source can add formatting but
+// never scripts, event handlers or javascript: URLs.
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	xhtml "golang.org/x/net/html"
+)
+
+// MaxLength is the longest markdown source accepted, in characters
+const MaxLength = 20000
+
+var (
+	converter = goldmark.New(
+		goldmark.WithExtensions(
+			extension.Strikethrough,
+			// Column alignment as align attributes, which the policy keeps,
+			// instead of style attributes, which it strips
+			extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		),
+		// Raw HTML reaches the sanitizer instead of being dropped silently
+		goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+	)
+	policy     = newPolicy()
+	textPolicy = bluemonday.StrictPolicy()
+)
+
+// newPolicy is the UGC policy plus code block languages and list start numbers
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[a-zA-Z0-9_+#-]{1,30}$`)).OnElements("code")
+	p.AllowAttrs("start").Matching(bluemonday.Integer).OnElements("ol")
+	return p
+}
+
+// Render converts a markdown source to sanitized HTML and lists what the
+// sanitizer removed
+func Render(src string) (string, []string) {
+	var raw bytes.Buffer
+	// Converting into a buffer only fails on writer errors
+	_ = converter.Convert([]byte(src), &raw)
+	return policy.Sanitize(raw.String()), removals(raw.String())
+}
+
+// Unsafe lists the content of a markdown source the sanitizer would remove,
+// such as <script> elements and unsafe link URLs; nil means it is safe
+func Unsafe(src string) []string {
+	_, removed := Render(src)
+	return removed
+}
+
+// Text converts a markdown source to plain text with collapsed whitespace,
+// for snippets such as search result and meta descriptions
+func Text(src string) string {
+	rendered, _ := Render(src)
+	return strings.Join(strings.Fields(html.UnescapeString(textPolicy.Sanitize(rendered))), " ")
+}
+
+// removals describes every element and attribute of rendered HTML the policy
+// removes, in order of first appearance. Each start tag is sanitized on its
+// own and compared with what is kept.
+func removals(rendered string) []string {
+	var removed []string
+	report := func(removal string) {
+		for _, r := range removed {
+			if r == removal {
+				return
+			}
+		}
+		removed = append(removed, removal)
+	}
+
+	z := xhtml.NewTokenizer(strings.NewReader(rendered))
+	for {
+		tt := z.Next()
+		if tt == xhtml.ErrorToken {
+			return removed
+		}
+		if tt != xhtml.StartTagToken && tt != xhtml.SelfClosingTagToken {
+			continue
+		}
+		token := z.Token()
+		kept := keptAttrs(token)
+		if kept == nil {
+			report(droppedElement(token))
+			continue
+		}
+		for _, attr := range token.Attr {
+			if !kept[attr.Key] {
+				report(removedAttr(token.Data, attr.Key))
+			}
+		}
+	}
+}
+
+// keptAttrs returns the attributes the policy keeps on a start tag, or nil
+// when it removes the element
+func keptAttrs(token xhtml.Token) map[string]bool {
+	z := xhtml.NewTokenizer(strings.NewReader(policy.Sanitize(token.String())))
+	for {
+		switch z.Next() {
+		case xhtml.ErrorToken:
+			return nil
+		case xhtml.StartTagToken, xhtml.SelfClosingTagToken:
+			sanitized := z.Token()
+			if sanitized.Data != token.Data {
+				continue
+			}
+			kept := make(map[string]bool, len(sanitized.Attr))
+			for _, attr := range sanitized.Attr {
+				kept[attr.Key] = true
+			}
+			return kept
+		}
+	}
+}
+
+// droppedElement describes why an element is removed. Links and images are
+// allowed but dropped when their URL is removed and nothing else is left.
+func droppedElement(token xhtml.Token) string {
+	for _, attr := range token.Attr {
+		if (token.Data == "a" && attr.Key == "href") || (token.Data == "img" && attr.Key == "src") {
+			return removedAttr(token.Data, attr.Key)
+		}
+	}
+	return "<" + token.Data + "> element"
+}
+
+func removedAttr(tag, key string) string {
+	switch {
+	case tag == "a" && key == "href":
+		return "unsafe link URL"
+	case tag == "img" && key == "src":
+		return "unsafe image URL"
+	}
+	return key + " attribute on <" + tag + ">"
+}
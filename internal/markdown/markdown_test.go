@@ -0,0 +1,73 @@
+package markdown
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name        string
+		src         string
+		want        string
+		wantRemoved []string
+	}{
+		{"heading and emphasis", "# Orders\n\nBuilt on **Kafka** and *Go*", "<h1>Orders</h1>\n<p>Built on <strong>Kafka</strong> and <em>Go</em></p>\n", nil},
+		{"nested emphasis", "***all*** *a **b** c* snake_case_name ~~old~~", "<p><em><strong>all</strong></em> <em>a <strong>b</strong> c</em> snake_case_name <del>old</del></p>\n", nil},
+		{"tight list", "- one\n- two\n  1. nested", "<ul>\n<li>one</li>\n<li>two\n<ol>\n<li>nested</li>\n</ol>\n</li>\n</ul>\n", nil},
+		{"loose ordered list", "3. three\n\n4. four", "<ol start=\"3\">\n<li>\n<p>three</p>\n</li>\n<li>\n<p>four</p>\n</li>\n</ol>\n", nil},
+		{"fenced code", "```go\nif a < b {}\n```", "<pre><code class=\"language-go\">if a &lt; b {}\n</code></pre>\n", nil},
+		{"block quote and break", "> quoted\n\n---", "<blockquote>\n<p>quoted</p>\n</blockquote>\n<hr>\n", nil},
+		{"link and image", `[repo](https://github.com/x "Source") ![diagram](/img/d.png)`,
+			"<p><a href=\"https://github.com/x\" title=\"Source\" rel=\"nofollow\">repo</a> <img src=\"/img/d.png\" alt=\"diagram\"></p>\n", nil},
+		{"aligned table", "| a | b |\n|:--|--:|\n| 1 | 2 |\n",
+			"<table>\n<thead>\n<tr>\n<th align=\"left\">a</th>\n<th align=\"right\">b</th>\n</tr>\n</thead>\n<tbody>\n<tr>\n<td align=\"left\">1</td>\n<td align=\"right\">2</td>\n</tr>\n</tbody>\n</table>\n", nil},
+		{"code span keeps html", "Use `<script>` here", "<p>Use <code>&lt;script&gt;</code> here</p>\n", nil},
+		{"script removed", "<script>alert(1)</script>", "", []string{"<script> element"}},
+		{"event handler removed", `Hi <b onclick="x()">there</b>`, "<p>Hi <b>there</b></p>\n", []string{"onclick attribute on <b>"}},
+		{"javascript link", "[x](javascript:alert(1)) [y](JaVa&#x09;script&#58;alert(1))", "<p>x y</p>\n", []string{"unsafe link URL"}},
+		{"data image", "![x](data:image/svg+xml,abc)", "<p><img alt=\"x\"></p>\n", []string{"unsafe image URL"}},
+		{"unsafe code class", `<code class="x onload">a</code>`, "<p><code>a</code></p>\n", []string{"class attribute on <code>"}},
+		{"iframe dropped with content", "<iframe src=x>fallback</iframe>after", "after", []string{"<iframe> element"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, removed := Render(tt.src)
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+			if fmt.Sprint(removed) != fmt.Sprint(tt.wantRemoved) {
+				t.Errorf("removed = %q, want %q", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestUnsafe(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{"markdown and safe html", "# Orders\n\n* a\n* b\n\nx < y, a&b, <br> and <https://example.com>", nil},
+		{"code is text", "```html\n<script>alert(1)</script>\n```\n\n`<iframe>` \\<script>", nil},
+		{"unsafe html and links", `<img src="x" onerror="alert(1)"> <a href="javascript:alert(1)" title="t">x</a><script>x</script>`,
+			[]string{"onerror attribute on <img>", "unsafe link URL", "<script> element"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Unsafe(tt.src); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Unsafe() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	got := Text("## Painting\n\nBase coat of **Macragge Blue**, then [wash](https://example.com) &amp; highlights.<script>x</script>")
+	if want := "Painting Base coat of Macragge Blue, then wash & highlights."; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
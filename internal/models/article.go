@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// Article statuses
+const (
+	ArticleStatusDraft     = "draft"
+	ArticleStatusPublished = "published"
+	ArticleStatusArchived  = "archived"
+)
+
+// Article is a blog post. The body is markdown. Slug and Tags are read-only:
+// slugs are derived from the title and changed with PUT
+// /blog/articles/{id}/slug, tags are set with PUT /blog/articles/{id}/tags.
+type Article struct {
+	ID          int64      `json:"id" gorm:"primaryKey"`
+	Title       string     `json:"title" binding:"required,max=200" example:"Replacing cron jobs with an outbox"`
+	Slug        string     `json:"slug,omitempty" gorm:"-" example:"replacing-cron-jobs-with-an-outbox"`
+	Summary     string     `json:"summary,omitempty" binding:"max=500" example:"How change events stopped getting lost between the database and the broker."`
+	Body        string     `json:"body,omitempty"`
+	CoverFileID *int64     `json:"coverFileId,omitempty" gorm:"column:cover_file_id" example:"12"`
+	Status      string     `json:"status" binding:"omitempty,oneof=draft published archived" example:"published"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty" gorm:"column:published_at"`
+	Tags        []string   `json:"tags,omitempty" gorm:"-"`
+	CreatedAt   time.Time  `json:"createdAt" gorm:"column:created_at"`
+	UpdatedAt   time.Time  `json:"updatedAt" gorm:"column:updated_at"`
+
+	// Associations
+	CoverFile *StorageFile   `json:"coverFile,omitempty" gorm:"foreignKey:CoverFileID"`
+	Skills    []ArticleSkill `json:"skills,omitempty" gorm:"foreignKey:ArticleID"`
+}
+
+func (Article) TableName() string {
+	return "blog.articles"
+}
+
+// ArticleSkill links an article to a skill it covers
+type ArticleSkill struct {
+	ID        int64     `json:"id" gorm:"primaryKey"`
+	ArticleID int64     `json:"articleId" gorm:"column:article_id"`
+	SkillID   int64     `json:"skillId" gorm:"column:skill_id"`
+	CreatedAt time.Time `json:"createdAt" gorm:"column:created_at"`
+
+	// Associations
+	Skill *Skill `json:"skill,omitempty" gorm:"foreignKey:SkillID"`
+}
+
+func (ArticleSkill) TableName() string {
+	return "blog.article_skills"
+}
+
+// ArticleFilter narrows the article list
+type ArticleFilter struct {
+	ListFilter
+	// Status keeps articles with this status; empty keeps every article
+	Status string
+}
@@ -0,0 +1,15 @@
+package models
+
+// CachePurgeRequest selects what to purge from the public API cache.
+// Entities use the entity type names (portfolio_project, miniature_theme, ...).
+// Keys must be inside the public API namespaces (portfolio:, miniatures:,
+// blog:). An empty request purges every mapped key.
+type CachePurgeRequest struct {
+	Entities []string `json:"entities" binding:"omitempty,dive,required"`
+	Keys     []string `json:"keys" binding:"omitempty,dive,required,max=200"`
+}
+
+// CachePurgeResponse lists the keys and patterns that were invalidated
+type CachePurgeResponse struct {
+	Purged []string `json:"purged"`
+}
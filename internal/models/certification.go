@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// CertificationResponse is a certification with its computed expiry status
+// (active, expiring or expired)
+type CertificationResponse struct {
+	Certification
+	Status          string `json:"status"`
+	DaysUntilExpiry *int   `json:"daysUntilExpiry,omitempty"`
+}
+
+// CertificationReminder records that an expiry reminder was sent for a
+// certification at a given threshold, so each threshold fires only once.
+// ExpiryDate is part of the key: renewing a certification re-arms its reminders.
+type CertificationReminder struct {
+	ID              int64     `json:"id" gorm:"primaryKey"`
+	CertificationID int64     `json:"certificationId" gorm:"column:certification_id"`
+	ThresholdDays   int       `json:"thresholdDays" gorm:"column:threshold_days"`
+	ExpiryDate      string    `json:"expiryDate" gorm:"column:expiry_date"`
+	SentAt          time.Time `json:"sentAt" gorm:"column:sent_at"`
+}
+
+func (CertificationReminder) TableName() string {
+	return "portfolio.certification_reminders"
+}
@@ -0,0 +1,10 @@
+package models
+
+// CloneSuffix is appended to the title of a cloned record
+const CloneSuffix = " (copy)"
+
+// CloneOptions controls what a clone copies besides the record and its links
+type CloneOptions struct {
+	// IncludeImages links the copy to the same image files
+	IncludeImages bool
+}
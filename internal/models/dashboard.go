@@ -0,0 +1,37 @@
+package models
+
+// Content quality check identifiers reported by the dashboard
+const (
+	CheckProjectMissingImage        = "project_missing_image"
+	CheckProjectMissingLinks        = "project_missing_links"
+	CheckExperienceMissingDesc      = "experience_missing_description"
+	CheckSkillUnused                = "skill_unused"
+	CheckCertificationExpiring      = "certification_expiring"
+	CheckMiniatureProjectNoImages   = "miniature_project_no_images"
+	CheckMiniatureThemeMissingCover = "miniature_theme_missing_cover"
+)
+
+// Dashboard is the content overview: record counts per entity and
+// content quality findings that editors should address
+type Dashboard struct {
+	Counts        []EntityCount    `json:"counts"`
+	Findings      []ContentFinding `json:"findings"`
+	FindingCounts map[string]int   `json:"findingCounts"`
+}
+
+// EntityCount is the number of stored records of one entity type
+type EntityCount struct {
+	Entity string `json:"entity"`
+	Count  int64  `json:"count"`
+}
+
+// ContentFinding is a single content quality issue on a specific record.
+// Path is the admin API path of the offending resource.
+type ContentFinding struct {
+	Check    string `json:"check"`
+	Entity   string `json:"entity"`
+	EntityID int64  `json:"entityId"`
+	Title    string `json:"title"`
+	Detail   string `json:"detail,omitempty"`
+	Path     string `json:"path,omitempty" gorm:"-"`
+}
@@ -0,0 +1,18 @@
+package models
+
+// DeleteOptions decides what happens to records that still reference an
+// entity being deleted. With neither set the delete is refused.
+type DeleteOptions struct {
+	// Cascade removes the references: link rows are deleted, optional
+	// references are cleared and required children are deleted with the entity
+	Cascade bool
+	// ReassignTo moves the references to another record of the same kind
+	ReassignTo *int64
+}
+
+// Dependent is a record that blocks a delete
+type Dependent struct {
+	Entity string `json:"entity" example:"skill"`
+	ID     int64  `json:"id" example:"12"`
+	Name   string `json:"name" example:"Go"`
+}
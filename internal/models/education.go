@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// Education is a degree or course of study on the resume. An entry without
+// an end date is still in progress.
+type Education struct {
+	ID           int64     `json:"id" gorm:"primaryKey"`
+	Institution  string    `json:"institution" binding:"required,max=200" example:"University of Latvia"`
+	Degree       string    `json:"degree" binding:"required,max=200" example:"Bachelor of Science"`
+	FieldOfStudy string    `json:"fieldOfStudy,omitempty" gorm:"column:field_of_study" binding:"max=200" example:"Computer Science"`
+	StartDate    string    `json:"startDate" gorm:"column:start_date" binding:"required" example:"2015-09-01"`
+	EndDate      *string   `json:"endDate,omitempty" gorm:"column:end_date" example:"2019-06-15"`
+	Grade        string    `json:"grade,omitempty" binding:"max=50" example:"With distinction"`
+	Description  string    `json:"description,omitempty"`
+	DisplayOrder int       `json:"displayOrder" gorm:"column:display_order"`
+	CreatedAt    time.Time `json:"createdAt" gorm:"column:created_at"`
+	UpdatedAt    time.Time `json:"updatedAt" gorm:"column:updated_at"`
+}
+
+func (Education) TableName() string {
+	return "portfolio.education"
+}
@@ -0,0 +1,21 @@
+package models
+
+// Entity type identifiers used by cross-cutting features that refer to
+// records of different kinds (dashboard findings, reports)
+const (
+	EntityProfile            = "profile"
+	EntityWorkExperience     = "work_experience"
+	EntityCertification      = "certification"
+	EntityEducation          = "education"
+	EntityTestimonial        = "testimonial"
+	EntitySkill              = "skill"
+	EntitySkillType          = "skill_type"
+	EntityPortfolioProject   = "portfolio_project"
+	EntityMiniatureTheme     = "miniature_theme"
+	EntityMiniatureProject   = "miniature_project"
+	EntityMiniaturePaint     = "miniature_paint"
+	EntityMiniatureTechnique = "miniature_technique"
+	EntityFile               = "file"
+	EntityTag                = "tag"
+	EntityArticle            = "article"
+)
@@ -0,0 +1,14 @@
+package models
+
+// MarkdownRenderRequest is markdown to preview as it will be published
+type MarkdownRenderRequest struct {
+	Markdown string `json:"markdown" binding:"max=20000" example:"Built on **Kafka**, see [the repo](https://github.com/example/orders)"`
+}
+
+// MarkdownPreview is sanitized HTML rendered from markdown
+type MarkdownPreview struct {
+	HTML string `json:"html" example:"<p>Built on <strong>Kafka</strong>, see <a href=\"https://github.com/example/orders\" rel=\"nofollow noopener\">the repo</a></p>"`
+	// Removed lists what the sanitizer took out, such as <script> elements
+	// and unsafe link URLs; saving markdown with any of them is rejected
+	Removed []string `json:"removed" example:"<script> element,onclick attribute on <b>"`
+}
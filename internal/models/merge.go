@@ -0,0 +1,27 @@
+package models
+
+// MergeRequest lists duplicates to fold into the record addressed by the URL
+type MergeRequest struct {
+	SourceIDs []int64 `json:"sourceIds" binding:"required,min=1,max=50,dive,gt=0" example:"3,7"`
+	// Preview reports what the merge would change without applying it
+	Preview bool `json:"preview" example:"true"`
+}
+
+// MergeReference counts the rows of one table a merge rewrites
+type MergeReference struct {
+	Table string `json:"table" example:"portfolio.project_technologies"`
+	// Repointed rows now reference the target
+	Repointed int64 `json:"repointed" example:"4"`
+	// Deduplicated rows were dropped because the target was already linked
+	Deduplicated int64 `json:"deduplicated" example:"1"`
+}
+
+// MergeResult describes an applied or previewed merge
+type MergeResult struct {
+	TargetID   int64            `json:"targetId" example:"2"`
+	SourceIDs  []int64          `json:"sourceIds" example:"3,7"`
+	Preview    bool             `json:"preview" example:"false"`
+	References []MergeReference `json:"references"`
+	// Dependents are the records whose references move to the target
+	Dependents []Dependent `json:"dependents"`
+}
@@ -0,0 +1,49 @@
+package models
+
+// MiniatureStatsFilter narrows the statistics to projects completed within
+// an inclusive date range. Dates use the YYYY-MM-DD format; nil means open-ended.
+type MiniatureStatsFilter struct {
+	From  *string
+	To    *string
+	Limit int
+}
+
+// MiniatureStats is the hobby output summary returned by the stats endpoint
+type MiniatureStats struct {
+	From              *string       `json:"from,omitempty"`
+	To                *string       `json:"to,omitempty"`
+	TotalProjects     int64         `json:"totalProjects"`
+	CompletedProjects int64         `json:"completedProjects"`
+	TotalTimeSpent    float64       `json:"totalTimeSpent"`
+	AverageTimeSpent  float64       `json:"averageTimeSpent"`
+	CompletedByMonth  []PeriodCount `json:"completedByMonth"`
+	CompletedByYear   []PeriodCount `json:"completedByYear"`
+	TopPaints         []NamedCount  `json:"topPaints"`
+	TopTechniques     []NamedCount  `json:"topTechniques"`
+	ByTheme           []NamedCount  `json:"byTheme"`
+	ByScale           []ValueCount  `json:"byScale"`
+	ByManufacturer    []ValueCount  `json:"byManufacturer"`
+	ByDifficulty      []ValueCount  `json:"byDifficulty"`
+}
+
+// PeriodCount is a number of items in a calendar period (YYYY or YYYY-MM)
+type PeriodCount struct {
+	Period string `json:"period"`
+	Count  int64  `json:"count"`
+}
+
+// NamedCount is a usage count for a referenced record (paint, technique, theme).
+// ID is nil for the "no reference" bucket, e.g. projects without a theme.
+// Detail carries secondary identification such as the paint manufacturer.
+type NamedCount struct {
+	ID     *int64 `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Detail string `json:"detail,omitempty"`
+	Count  int64  `json:"count"`
+}
+
+// ValueCount is a count of projects sharing the same free-text attribute value
+type ValueCount struct {
+	Value string `json:"value"`
+	Count int64  `json:"count"`
+}
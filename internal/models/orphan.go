@@ -0,0 +1,38 @@
+package models
+
+// OrphanedFile is a storage file nothing links to. Purgeable is true once it
+// is older than the grace period, which protects uploads that are about to be linked.
+type OrphanedFile struct {
+	StorageFile
+	Purgeable bool `json:"purgeable"`
+}
+
+// OrphanReport lists orphaned storage files
+type OrphanReport struct {
+	GracePeriod    string         `json:"gracePeriod"`
+	Total          int            `json:"total"`
+	Purgeable      int            `json:"purgeable"`
+	PurgeableBytes int64          `json:"purgeableBytes"`
+	Files          []OrphanedFile `json:"files"`
+}
+
+// OrphanPurgeRequest confirms an orphan purge. FileIDs limits the purge to
+// those files; empty purges every purgeable orphan.
+type OrphanPurgeRequest struct {
+	Confirm bool    `json:"confirm"`
+	FileIDs []int64 `json:"fileIds" binding:"omitempty,max=1000,dive,min=1"`
+}
+
+// OrphanPurgeFailure is a file files-api could not delete
+type OrphanPurgeFailure struct {
+	FileID int64  `json:"fileId"`
+	Error  string `json:"error"`
+}
+
+// OrphanPurgeResult reports the outcome of a purge. Skipped lists requested
+// files that are linked again or still within the grace period.
+type OrphanPurgeResult struct {
+	Deleted []int64              `json:"deleted"`
+	Skipped []int64              `json:"skipped"`
+	Failed  []OrphanPurgeFailure `json:"failed"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OutboxEvent is a domain event written in the same transaction as the change
+// it describes. The outbox relay publishes pending rows to RabbitMQ and marks
+// them published; Payload is the JSON message body sent to the broker.
+type OutboxEvent struct {
+	ID          int64           `json:"id" gorm:"primaryKey"`
+	EventID     string          `json:"eventId" gorm:"column:event_id"`
+	EventType   string          `json:"eventType" gorm:"column:event_type"`
+	Entity      string          `json:"entity"`
+	EntityID    int64           `json:"entityId" gorm:"column:entity_id"`
+	Payload     json.RawMessage `json:"payload" gorm:"column:payload;serializer:json" swaggertype:"object"`
+	Attempts    int             `json:"attempts"`
+	LastError   *string         `json:"lastError,omitempty" gorm:"column:last_error"`
+	PublishedAt *time.Time      `json:"publishedAt,omitempty" gorm:"column:published_at"`
+	CreatedAt   time.Time       `json:"createdAt" gorm:"column:created_at"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "portfolio.outbox_events"
+}
@@ -0,0 +1,16 @@
+package models
+
+// Permission resources that are not part of the portfolio-common resource
+// set; roles need matching scopes in auth-service.
+const (
+	// ResourceWebhooks guards webhook management
+	ResourceWebhooks = "webhooks"
+	// ResourceCache guards manual public API cache purges
+	ResourceCache = "cache"
+	// ResourceEducation guards education entries
+	ResourceEducation = "education"
+	// ResourceTestimonials guards testimonials
+	ResourceTestimonials = "testimonials"
+	// ResourceBlog guards blog articles
+	ResourceBlog = "blog"
+)
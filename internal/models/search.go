@@ -0,0 +1,36 @@
+package models
+
+// SearchableEntities are the entity types covered by the admin content search
+var SearchableEntities = []string{
+	EntityPortfolioProject,
+	EntityWorkExperience,
+	EntityCertification,
+	EntitySkill,
+	EntityMiniatureProject,
+	EntityMiniatureTheme,
+	EntityArticle,
+}
+
+// SearchQuery is a full-text search restricted to the given entity types
+type SearchQuery struct {
+	Text     string
+	Entities []string
+	Limit    int
+}
+
+// SearchHit is one ranked match. Path is the admin API path of the record.
+type SearchHit struct {
+	Entity   string `json:"entity" example:"portfolio_project"`
+	EntityID int64  `json:"entityId" example:"12"`
+	Title    string `json:"title" example:"Order pipeline"`
+	// Snippet is HTML-escaped text around the matches, each wrapped in <mark>
+	Snippet string  `json:"snippet" example:"Streams orders through <mark>Kafka</mark> topics"`
+	Rank    float64 `json:"rank" example:"0.61"`
+	Path    string  `json:"path" gorm:"-" example:"/portfolio/projects/12"`
+}
+
+// SearchResults are the hits for a query, best match first
+type SearchResults struct {
+	Query string      `json:"query" example:"kafka"`
+	Hits  []SearchHit `json:"hits"`
+}
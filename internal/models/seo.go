@@ -0,0 +1,51 @@
+package models
+
+import "time"
+
+// SEOEntities are the entity types with SEO metadata
+var SEOEntities = []string{EntityProfile, EntityPortfolioProject, EntityMiniatureProject, EntityMiniatureTheme, EntityArticle}
+
+// SEOMetadata holds the SEO overrides stored for a record. Empty fields fall
+// back to defaults derived from the record.
+type SEOMetadata struct {
+	ID              int64     `json:"-" gorm:"primaryKey"`
+	Entity          string    `json:"-"`
+	EntityID        int64     `json:"-" gorm:"column:entity_id"`
+	MetaTitle       string    `json:"metaTitle,omitempty" gorm:"column:meta_title" binding:"max=200" example:"Order pipeline on Kafka"`
+	MetaDescription string    `json:"metaDescription,omitempty" gorm:"column:meta_description" binding:"max=500"`
+	CanonicalURL    string    `json:"canonicalUrl,omitempty" gorm:"column:canonical_url" binding:"max=500" example:"https://example.com/projects/order-pipeline"`
+	OGImageFileID   *int64    `json:"ogImageFileId,omitempty" gorm:"column:og_image_file_id"`
+	UpdatedAt       time.Time `json:"updatedAt,omitempty" gorm:"column:updated_at"`
+}
+
+func (SEOMetadata) TableName() string {
+	return "portfolio.seo_metadata"
+}
+
+// SEO is the metadata a public page uses: the stored overrides, with defaults
+// derived from the record's title, description and image for fields left empty
+type SEO struct {
+	Entity          string       `json:"entity" example:"portfolio_project"`
+	EntityID        int64        `json:"entityId" example:"12"`
+	MetaTitle       string       `json:"metaTitle" example:"Order pipeline"`
+	MetaDescription string       `json:"metaDescription"`
+	CanonicalURL    string       `json:"canonicalUrl,omitempty"`
+	OGImage         *StorageFile `json:"ogImage,omitempty"`
+	// Overrides are the stored fields; the others are derived
+	Overrides SEOMetadata `json:"overrides"`
+	Issues    []SEOIssue  `json:"issues"`
+	// Path is the admin API path of the record (set in reports)
+	Path string `json:"path,omitempty" example:"/portfolio/projects/12"`
+}
+
+// SEOIssue is a problem with the metadata of one page
+type SEOIssue struct {
+	Field   string `json:"field" example:"metaTitle"`
+	Message string `json:"message" example:"is 72 characters, search results show about 60"`
+}
+
+// SEOReport lists the records whose SEO metadata has issues
+type SEOReport struct {
+	Checked int   `json:"checked" example:"42"`
+	Items   []SEO `json:"items"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Slug is a URL slug of a portfolio project, miniature project, theme or
+// article. A record has one current slug; the slugs it replaced are kept so
+// the public site can redirect URLs that used them.
+type Slug struct {
+	ID        int64     `json:"-" gorm:"primaryKey"`
+	Entity    string    `json:"-"`
+	EntityID  int64     `json:"-" gorm:"column:entity_id"`
+	Slug      string    `json:"slug" example:"order-pipeline"`
+	IsCurrent bool      `json:"-" gorm:"column:is_current"`
+	CreatedAt time.Time `json:"createdAt" gorm:"column:created_at"`
+}
+
+func (Slug) TableName() string {
+	return "portfolio.slugs"
+}
+
+// Slugs are the current slug of a record and the slugs it replaced, newest first
+type Slugs struct {
+	Current  string `json:"current" example:"order-pipeline"`
+	Previous []Slug `json:"previous"`
+}
+
+// SlugRequest changes the slug of a record
+type SlugRequest struct {
+	Slug string `json:"slug" binding:"required,max=100" example:"order-pipeline"`
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"time"
+
+	common "github.com/GunarsK-portfolio/portfolio-common/middleware"
+)
+
+// TagResources guard tags, which label portfolio, miniature and blog content:
+// a permission level on any of these resources applies to tags
+var TagResources = []string{common.ResourceProjects, common.ResourceMiniatures, ResourceBlog}
+
+// TaggableEntities are the entity types that can carry tags
+var TaggableEntities = []string{EntityPortfolioProject, EntityMiniatureProject, EntityMiniatureTheme, EntityArticle}
+
+// Tag is a label shared by portfolio and miniature content. Names are unique
+// regardless of case.
+type Tag struct {
+	ID          int64  `json:"id" gorm:"primaryKey"`
+	Name        string `json:"name" binding:"required,max=50" example:"event-driven"`
+	Description string `json:"description,omitempty" binding:"max=255"`
+	// UsageCount is the number of tagged records; Usage splits it by entity type
+	UsageCount int64            `json:"usageCount" gorm:"->;column:usage_count" example:"5"`
+	Usage      map[string]int64 `json:"usage,omitempty" gorm:"-"`
+	CreatedAt  time.Time        `json:"createdAt" gorm:"column:created_at"`
+	UpdatedAt  time.Time        `json:"updatedAt" gorm:"column:updated_at"`
+}
+
+func (Tag) TableName() string {
+	return "portfolio.tags"
+}
+
+// Tagging attaches a tag to a record of a taggable entity type
+type Tagging struct {
+	ID        int64     `json:"id" gorm:"primaryKey"`
+	TagID     int64     `json:"tagId" gorm:"column:tag_id"`
+	Entity    string    `json:"entity"`
+	EntityID  int64     `json:"entityId" gorm:"column:entity_id"`
+	CreatedAt time.Time `json:"createdAt" gorm:"column:created_at"`
+}
+
+func (Tagging) TableName() string {
+	return "portfolio.taggings"
+}
+
+// ListFilter narrows list endpoints
+type ListFilter struct {
+	// Tag keeps records tagged with this name (case-insensitive)
+	Tag string
+}
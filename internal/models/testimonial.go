@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// TestimonialRelationships are the accepted relationship values
+var TestimonialRelationships = []string{"manager", "colleague", "report", "client", "mentor", "other"}
+
+// Testimonial is a quote from a former colleague or client. It can be linked
+// to the work experience or the portfolio project it is about, and is only
+// shown publicly once approved.
+type Testimonial struct {
+	ID                 int64     `json:"id" gorm:"primaryKey"`
+	AuthorName         string    `json:"authorName" gorm:"column:author_name" binding:"required,max=100" example:"Jane Smith"`
+	AuthorRole         string    `json:"authorRole,omitempty" gorm:"column:author_role" binding:"max=100" example:"Engineering Manager"`
+	Company            string    `json:"company,omitempty" binding:"max=200" example:"Tech Corp"`
+	Relationship       string    `json:"relationship,omitempty" binding:"omitempty,oneof=manager colleague report client mentor other" example:"manager"`
+	Quote              string    `json:"quote" binding:"required,max=2000" example:"A thoughtful engineer who leaves every system better than they found it."`
+	AvatarFileID       *int64    `json:"avatarFileId,omitempty" gorm:"column:avatar_file_id" example:"12"`
+	WorkExperienceID   *int64    `json:"workExperienceId,omitempty" gorm:"column:work_experience_id" example:"3"`
+	PortfolioProjectID *int64    `json:"portfolioProjectId,omitempty" gorm:"column:portfolio_project_id"`
+	Approved           bool      `json:"approved"`
+	DisplayOrder       int       `json:"displayOrder" gorm:"column:display_order"`
+	CreatedAt          time.Time `json:"createdAt" gorm:"column:created_at"`
+	UpdatedAt          time.Time `json:"updatedAt" gorm:"column:updated_at"`
+
+	AvatarFile *StorageFile `json:"avatarFile,omitempty" gorm:"foreignKey:AvatarFileID"`
+}
+
+func (Testimonial) TableName() string {
+	return "portfolio.testimonials"
+}
+
+// TestimonialFilter narrows the testimonial list; nil fields match everything
+type TestimonialFilter struct {
+	Approved           *bool
+	WorkExperienceID   *int64
+	PortfolioProjectID *int64
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Translation is one text field of a record in another language. List fields
+// hold their translated items as a JSON array.
+type Translation struct {
+	ID        int64     `json:"-" gorm:"primaryKey"`
+	Entity    string    `json:"-"`
+	EntityID  int64     `json:"-" gorm:"column:entity_id"`
+	Field     string    `json:"field"`
+	Locale    string    `json:"locale"`
+	Value     string    `json:"value"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"column:updated_at"`
+}
+
+func (Translation) TableName() string {
+	return "portfolio.translations"
+}
+
+// TranslationRequest replaces the translations of a record in one locale.
+// Fields are keyed by the JSON name of the record field and hold a string, or
+// a list of strings for list fields. Fields left out or empty use the
+// record's own text.
+type TranslationRequest struct {
+	Fields map[string]json.RawMessage `json:"fields" binding:"required" swaggertype:"object"`
+}
+
+// Translations are the translated fields of a record by locale and field
+type Translations struct {
+	Entity   string                            `json:"entity" example:"portfolio_project"`
+	EntityID int64                             `json:"entityId" example:"12"`
+	Locales  map[string]map[string]interface{} `json:"locales"`
+}
+
+// MissingTranslation lists the fields of a record that have text in the
+// default locale but none in Locale
+type MissingTranslation struct {
+	Entity   string   `json:"entity" example:"portfolio_project"`
+	EntityID int64    `json:"entityId" example:"12"`
+	Title    string   `json:"title" example:"Order pipeline"`
+	Locale   string   `json:"locale" example:"lv"`
+	Fields   []string `json:"fields" example:"description,challenges"`
+	// Path is the admin API path of the record
+	Path string `json:"path,omitempty" example:"/portfolio/projects/12"`
+}
+
+// MissingTranslationsReport lists the untranslated fields per record and locale
+type MissingTranslationsReport struct {
+	Locales []string             `json:"locales" example:"lv"`
+	Items   []MissingTranslation `json:"items"`
+}
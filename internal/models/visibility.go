@@ -0,0 +1,7 @@
+package models
+
+// Visibility is whether a portfolio or miniature project is shown on the
+// public site. Clones start hidden.
+type Visibility struct {
+	Visible *bool `json:"visible" binding:"required" example:"true"`
+}
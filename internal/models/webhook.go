@@ -0,0 +1,53 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Webhook delivery statuses
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// Webhook is an outbound endpoint notified about content change events.
+// Events holds event name filters: exact names (portfolio.project.updated),
+// prefix wildcards (miniatures.*) or * for everything.
+// Secret is write-only: it signs payloads and is never returned.
+type Webhook struct {
+	ID        int64     `json:"id" gorm:"primaryKey"`
+	Name      string    `json:"name" binding:"required,max=100"`
+	URL       string    `json:"url" binding:"required,url,max=500"`
+	Secret    string    `json:"secret,omitempty" binding:"omitempty,min=16,max=200"`
+	Events    []string  `json:"events" gorm:"column:events;serializer:json" binding:"required,min=1,dive,required,max=100"`
+	Disabled  bool      `json:"disabled"`
+	CreatedAt time.Time `json:"createdAt" gorm:"column:created_at"`
+	UpdatedAt time.Time `json:"updatedAt" gorm:"column:updated_at"`
+}
+
+func (Webhook) TableName() string {
+	return "portfolio.webhooks"
+}
+
+// WebhookDelivery is one event delivered (or being retried) to a webhook
+type WebhookDelivery struct {
+	ID             int64           `json:"id" gorm:"primaryKey"`
+	WebhookID      int64           `json:"webhookId" gorm:"column:webhook_id"`
+	EventID        string          `json:"eventId" gorm:"column:event_id"`
+	EventType      string          `json:"eventType" gorm:"column:event_type"`
+	Payload        json.RawMessage `json:"payload" gorm:"column:payload;serializer:json" swaggertype:"object"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	ResponseStatus *int            `json:"responseStatus,omitempty" gorm:"column:response_status"`
+	LastError      *string         `json:"lastError,omitempty" gorm:"column:last_error"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty" gorm:"column:next_attempt_at"`
+	DeliveredAt    *time.Time      `json:"deliveredAt,omitempty" gorm:"column:delivered_at"`
+	CreatedAt      time.Time       `json:"createdAt" gorm:"column:created_at"`
+	UpdatedAt      time.Time       `json:"updatedAt" gorm:"column:updated_at"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "portfolio.webhook_deliveries"
+}
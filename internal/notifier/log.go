@@ -0,0 +1,30 @@
+package notifier
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogNotifier writes notifications to the structured application log
+type LogNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogNotifier creates a notifier that logs at warn level.
+// A nil logger falls back to slog.Default().
+func NewLogNotifier(logger *slog.Logger) *LogNotifier {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LogNotifier{logger: logger}
+}
+
+// Notify implements Notifier
+func (n *LogNotifier) Notify(ctx context.Context, notification Notification) error {
+	n.logger.WarnContext(ctx, notification.Subject,
+		"event", notification.Event,
+		"message", notification.Message,
+		"data", notification.Data,
+	)
+	return nil
+}
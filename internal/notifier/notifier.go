@@ -0,0 +1,38 @@
+// Package notifier delivers operational notifications (e.g. certification
+// reminders) through pluggable channels: log, SMTP and webhook.
+package notifier
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Notification is a single message sent to one or more channels
+type Notification struct {
+	Event   string         `json:"event"`
+	Subject string         `json:"subject"`
+	Message string         `json:"message"`
+	Data    map[string]any `json:"data,omitempty"`
+	Time    time.Time      `json:"time"`
+}
+
+// Notifier delivers notifications to a channel
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Multi fans a notification out to every notifier.
+// All notifiers are attempted; failures are joined into one error.
+type Multi []Notifier
+
+// Notify implements Notifier
+func (m Multi) Notify(ctx context.Context, n Notification) error {
+	var errs []error
+	for _, notifier := range m {
+		if err := notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,169 @@
+package notifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testNotification() Notification {
+	return Notification{
+		Event:   "certification.expiring",
+		Subject: "Certification expiring in 7 days: CKA\r\nBcc: attacker@example.com",
+		Message: "CKA expires soon.",
+		Data:    map[string]any{"certificationId": 1},
+		Time:    time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestWebhookNotifier_PostsJSON(t *testing.T) {
+	var received Notification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected request %s %s", r.Method, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if err := NewWebhookNotifier(server.URL, server.Client()).Notify(context.Background(), testNotification()); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if received.Event != "certification.expiring" {
+		t.Errorf("received event = %s, want certification.expiring", received.Event)
+	}
+}
+
+func TestWebhookNotifier_Non2xxIsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	if err := NewWebhookNotifier(server.URL, server.Client()).Notify(context.Background(), testNotification()); err == nil {
+		t.Error("Notify() error = nil, want error for 502 response")
+	}
+}
+
+type failingNotifier struct{ calls int }
+
+func (n *failingNotifier) Notify(ctx context.Context, notification Notification) error {
+	n.calls++
+	return errors.New("boom")
+}
+
+func TestMulti_AttemptsAllNotifiers(t *testing.T) {
+	first, second := &failingNotifier{}, &failingNotifier{}
+
+	if err := (Multi{first, second}).Notify(context.Background(), testNotification()); err == nil {
+		t.Error("Notify() error = nil, want joined error")
+	}
+	if first.calls != 1 || second.calls != 1 {
+		t.Errorf("calls = %d/%d, want 1/1", first.calls, second.calls)
+	}
+}
+
+// fakeSMTPServer is a minimal plain-text SMTP stand-in that records the DATA payload
+func fakeSMTPServer(t *testing.T) (host string, port int, data <-chan string) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	out := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+		reply("220 localhost ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "DATA"):
+				reply("354 go ahead")
+				var body strings.Builder
+				for {
+					l, err := reader.ReadString('\n')
+					if err != nil || l == ".\r\n" {
+						break
+					}
+					body.WriteString(l)
+				}
+				out <- body.String()
+				reply("250 queued")
+			case strings.HasPrefix(cmd, "QUIT"):
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+
+	addr := listener.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, out
+}
+
+func TestSMTPNotifier_SendsMail(t *testing.T) {
+	host, port, data := fakeSMTPServer(t)
+	n := NewSMTPNotifier(SMTPConfig{
+		Host: host,
+		Port: port,
+		From: "admin-api@example.com",
+		To:   []string{"owner@example.com"},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := n.Notify(ctx, testNotification()); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	msg := <-data
+	if !strings.Contains(msg, "To: owner@example.com\r\n") {
+		t.Errorf("message missing To header: %q", msg)
+	}
+	if strings.Contains(msg, "\r\nBcc:") {
+		t.Errorf("subject line break was not sanitized: %q", msg)
+	}
+	if !strings.Contains(msg, "CKA expires soon.") {
+		t.Errorf("message missing body: %q", msg)
+	}
+}
+
+func TestSMTPNotifier_ConnectionError(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	n := NewSMTPNotifier(SMTPConfig{Host: "127.0.0.1", Port: port, From: "a@example.com", To: []string{"b@example.com"}})
+	if err := n.Notify(context.Background(), testNotification()); err == nil {
+		t.Error("Notify() error = nil, want connection error")
+	}
+}
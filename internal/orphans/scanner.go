@@ -0,0 +1,131 @@
+// Package orphans finds storage files nothing links to any more and deletes
+// them through files-api. Unlinking an image, deleting a project or replacing
+// an avatar only drops the reference; the file itself is left behind until a
+// purge. Files younger than the grace period are never purged so an upload
+// that is about to be linked is not lost.
+package orphans
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// DefaultGracePeriod is used when Config.GracePeriod is zero
+const DefaultGracePeriod = 24 * time.Hour
+
+// Store finds unreferenced files (implemented by repository.Repository)
+type Store interface {
+	GetOrphanedFiles(ctx context.Context) ([]models.StorageFile, error)
+}
+
+// Deleter removes a file object and its storage.files row (files-api)
+type Deleter interface {
+	DeleteFile(ctx context.Context, id int64) error
+}
+
+// Config configures the scanner
+type Config struct {
+	// GracePeriod is how old an orphaned file must be before it is purged
+	GracePeriod time.Duration
+}
+
+// Scanner reports and purges orphaned files
+type Scanner struct {
+	store   Store
+	deleter Deleter
+	cfg     Config
+	logger  *slog.Logger
+	now     func() time.Time
+}
+
+// NewScanner creates a scanner. A nil logger falls back to slog.Default().
+func NewScanner(store Store, deleter Deleter, cfg Config, logger *slog.Logger) *Scanner {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.GracePeriod <= 0 {
+		cfg.GracePeriod = DefaultGracePeriod
+	}
+	return &Scanner{
+		store:   store,
+		deleter: deleter,
+		cfg:     cfg,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Scan lists every orphaned file and whether it is past the grace period
+func (s *Scanner) Scan(ctx context.Context) (*models.OrphanReport, error) {
+	files, err := s.store.GetOrphanedFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := s.now().Add(-s.cfg.GracePeriod)
+	report := &models.OrphanReport{
+		GracePeriod: s.cfg.GracePeriod.String(),
+		Total:       len(files),
+		Files:       make([]models.OrphanedFile, 0, len(files)),
+	}
+	for _, file := range files {
+		purgeable := file.CreatedAt.Before(cutoff)
+		if purgeable {
+			report.Purgeable++
+			report.PurgeableBytes += file.FileSize
+		}
+		report.Files = append(report.Files, models.OrphanedFile{StorageFile: file, Purgeable: purgeable})
+	}
+	return report, nil
+}
+
+// Purge deletes purgeable orphans, limited to fileIDs when given. Orphans are
+// found again at purge time so a file linked since the last report is kept.
+// A failed delete is reported and the purge moves on to the next file.
+func (s *Scanner) Purge(ctx context.Context, fileIDs []int64) (*models.OrphanPurgeResult, error) {
+	report, err := s.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.OrphanPurgeResult{
+		Deleted: []int64{},
+		Skipped: []int64{},
+		Failed:  []models.OrphanPurgeFailure{},
+	}
+	purgeable := make(map[int64]bool, len(report.Files))
+	for _, file := range report.Files {
+		purgeable[file.ID] = file.Purgeable
+	}
+
+	targets := fileIDs
+	if len(targets) == 0 {
+		for _, file := range report.Files {
+			if file.Purgeable {
+				targets = append(targets, file.ID)
+			}
+		}
+	}
+
+	for _, id := range slices.Compact(slices.Sorted(slices.Values(targets))) {
+		if !purgeable[id] {
+			result.Skipped = append(result.Skipped, id)
+			continue
+		}
+		if err := s.deleter.DeleteFile(ctx, id); err != nil {
+			s.logger.Error("Failed to purge orphaned file", "file_id", id, "error", err)
+			result.Failed = append(result.Failed, models.OrphanPurgeFailure{FileID: id, Error: err.Error()})
+			continue
+		}
+		result.Deleted = append(result.Deleted, id)
+	}
+
+	if len(result.Deleted) > 0 {
+		s.logger.Info("Purged orphaned files", "deleted", len(result.Deleted), "failed", len(result.Failed))
+	}
+	return result, nil
+}
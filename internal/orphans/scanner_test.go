@@ -0,0 +1,101 @@
+package orphans
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+var now = time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+
+type fakeStore []models.StorageFile
+
+func (s fakeStore) GetOrphanedFiles(ctx context.Context) ([]models.StorageFile, error) {
+	return s, nil
+}
+
+type fakeDeleter struct {
+	deleted []int64
+	fail    map[int64]bool
+}
+
+func (d *fakeDeleter) DeleteFile(ctx context.Context, id int64) error {
+	if d.fail[id] {
+		return errors.New("files-api returned status 500")
+	}
+	d.deleted = append(d.deleted, id)
+	return nil
+}
+
+func newTestScanner(store Store, deleter Deleter) *Scanner {
+	s := NewScanner(store, deleter, Config{GracePeriod: 24 * time.Hour}, nil)
+	s.now = func() time.Time { return now }
+	return s
+}
+
+func testFiles() fakeStore {
+	return fakeStore{
+		{ID: 1, FileSize: 100, CreatedAt: now.Add(-72 * time.Hour)},
+		{ID: 2, FileSize: 50, CreatedAt: now.Add(-25 * time.Hour)},
+		{ID: 3, FileSize: 10, CreatedAt: now.Add(-time.Hour)}, // within grace period
+	}
+}
+
+func TestScan_MarksFilesPastGracePeriod(t *testing.T) {
+	report, err := newTestScanner(testFiles(), &fakeDeleter{}).Scan(context.Background())
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+
+	if report.Total != 3 || report.Purgeable != 2 || report.PurgeableBytes != 150 {
+		t.Errorf("Scan() total %d, purgeable %d (%d bytes); want 3, 2 (150 bytes)",
+			report.Total, report.Purgeable, report.PurgeableBytes)
+	}
+	if report.Files[2].Purgeable {
+		t.Error("file within grace period marked purgeable")
+	}
+	if report.GracePeriod != "24h0m0s" {
+		t.Errorf("GracePeriod = %q, want 24h0m0s", report.GracePeriod)
+	}
+}
+
+func TestPurge_DeletesPurgeableOrphansAndReportsFailures(t *testing.T) {
+	deleter := &fakeDeleter{fail: map[int64]bool{2: true}}
+	result, err := newTestScanner(testFiles(), deleter).Purge(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+
+	if !slices.Equal(result.Deleted, []int64{1}) {
+		t.Errorf("Deleted = %v, want [1]", result.Deleted)
+	}
+	if len(result.Failed) != 1 || result.Failed[0].FileID != 2 {
+		t.Errorf("Failed = %v, want file 2", result.Failed)
+	}
+	if len(result.Skipped) != 0 {
+		t.Errorf("Skipped = %v, want none (file 3 was not requested)", result.Skipped)
+	}
+}
+
+func TestPurge_SelectedFilesSkipsLinkedAndRecent(t *testing.T) {
+	deleter := &fakeDeleter{}
+	// 3 is within the grace period, 9 is not orphaned (linked again since the report)
+	result, err := newTestScanner(testFiles(), deleter).Purge(context.Background(), []int64{9, 2, 3, 2})
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+
+	if !slices.Equal(result.Deleted, []int64{2}) {
+		t.Errorf("Deleted = %v, want [2]", result.Deleted)
+	}
+	if !slices.Equal(result.Skipped, []int64{3, 9}) {
+		t.Errorf("Skipped = %v, want [3 9]", result.Skipped)
+	}
+	if !slices.Equal(deleter.deleted, []int64{2}) {
+		t.Errorf("files-api deletes = %v, want [2]", deleter.deleted)
+	}
+}
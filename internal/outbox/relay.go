@@ -0,0 +1,170 @@
+// Package outbox relays domain events from the transactional outbox table to
+// a message broker. Repository mutations write events in the same transaction
+// as the change; the relay publishes pending rows in order and marks them
+// published only after the broker confirms, giving at-least-once delivery.
+// Consumers must deduplicate on the event id.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// Defaults used when Config fields are zero
+const (
+	DefaultPollInterval = time.Second
+	DefaultBatchSize    = 100
+	DefaultRetention    = 7 * 24 * time.Hour
+	pruneInterval       = time.Hour
+	maxErrorLength      = 500
+)
+
+// Store is the persistence the relay needs (implemented by repository.Repository)
+type Store interface {
+	GetPendingOutboxEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error
+	RecordOutboxEventFailure(ctx context.Context, id int64, lastError string) error
+	DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Publisher sends one message to the broker and returns once it is confirmed.
+// queue.RabbitMQPublisher from portfolio-common satisfies it.
+type Publisher interface {
+	Publish(ctx context.Context, message interface{}) error
+}
+
+// Config configures polling and retention
+type Config struct {
+	// PollInterval is how often pending events are picked up
+	PollInterval time.Duration
+	// BatchSize is the maximum number of events published per poll
+	BatchSize int
+	// Retention is how long published events are kept; negative disables pruning
+	Retention time.Duration
+}
+
+// Relay publishes pending outbox events
+type Relay struct {
+	store     Store
+	publisher Publisher
+	cfg       Config
+	logger    *slog.Logger
+	now       func() time.Time
+	lastPrune time.Time
+}
+
+// NewRelay creates a relay. A nil logger falls back to slog.Default().
+func NewRelay(store Store, publisher Publisher, cfg Config, logger *slog.Logger) *Relay {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+	if cfg.Retention == 0 {
+		cfg.Retention = DefaultRetention
+	}
+
+	return &Relay{
+		store:     store,
+		publisher: publisher,
+		cfg:       cfg,
+		logger:    logger,
+		now:       time.Now,
+	}
+}
+
+// Run relays pending events until ctx is cancelled
+func (r *Relay) Run(ctx context.Context) {
+	r.logger.Info("Outbox relay started", "pollInterval", r.cfg.PollInterval.String(), "batchSize", r.cfg.BatchSize)
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("Outbox relay stopped")
+			return
+		case <-ticker.C:
+			r.drain(ctx)
+			r.prune(ctx)
+		}
+	}
+}
+
+// drain publishes full batches back to back until the outbox is empty or a publish fails
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := r.RelayOnce(ctx)
+		if err != nil {
+			r.logger.Error("Outbox relay failed", "published", published, "error", err)
+			return
+		}
+		if published < r.cfg.BatchSize {
+			return
+		}
+	}
+}
+
+// RelayOnce publishes one batch of pending events in order and returns how many
+// were published. It stops at the first failed publish so events are never
+// reordered; the failed event is retried on the next poll.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	pending, err := r.store.GetPendingOutboxEvents(ctx, r.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, event := range pending {
+		if err := r.publisher.Publish(ctx, json.RawMessage(event.Payload)); err != nil {
+			if recErr := r.store.RecordOutboxEventFailure(ctx, event.ID, truncate(err.Error())); recErr != nil {
+				r.logger.Error("Failed to record outbox failure", "eventId", event.EventID, "error", recErr)
+			}
+			return published, err
+		}
+		// A failure here republishes the event on the next poll (at-least-once)
+		if err := r.store.MarkOutboxEventPublished(ctx, event.ID, r.now().UTC()); err != nil {
+			return published, err
+		}
+		published++
+	}
+	return published, nil
+}
+
+// prune deletes published events older than the retention, at most once per hour
+func (r *Relay) prune(ctx context.Context) {
+	if r.cfg.Retention < 0 {
+		return
+	}
+	now := r.now()
+	if now.Sub(r.lastPrune) < pruneInterval {
+		return
+	}
+	r.lastPrune = now
+
+	deleted, err := r.store.DeletePublishedOutboxEvents(ctx, now.Add(-r.cfg.Retention))
+	if err != nil {
+		r.logger.Error("Failed to prune outbox", "error", err)
+		return
+	}
+	if deleted > 0 {
+		r.logger.Info("Pruned published outbox events", "deleted", deleted)
+	}
+}
+
+// truncate keeps stored error messages bounded
+func truncate(s string) string {
+	if len(s) > maxErrorLength {
+		return s[:maxErrorLength]
+	}
+	return s
+}
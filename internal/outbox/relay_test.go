@@ -0,0 +1,175 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// fakeStore keeps outbox rows in memory
+type fakeStore struct {
+	rows []*models.OutboxEvent
+}
+
+func (s *fakeStore) add(eventID string) {
+	s.rows = append(s.rows, &models.OutboxEvent{
+		ID:      int64(len(s.rows) + 1),
+		EventID: eventID,
+		Payload: json.RawMessage(`{"id":"` + eventID + `"}`),
+	})
+}
+
+func (s *fakeStore) GetPendingOutboxEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	var pending []models.OutboxEvent
+	for _, row := range s.rows {
+		if row.PublishedAt == nil && len(pending) < limit {
+			pending = append(pending, *row)
+		}
+	}
+	return pending, nil
+}
+
+func (s *fakeStore) MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	s.rows[id-1].PublishedAt = &publishedAt
+	s.rows[id-1].Attempts++
+	return nil
+}
+
+func (s *fakeStore) RecordOutboxEventFailure(ctx context.Context, id int64, lastError string) error {
+	s.rows[id-1].Attempts++
+	s.rows[id-1].LastError = &lastError
+	return nil
+}
+
+func (s *fakeStore) DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	var kept []*models.OutboxEvent
+	var deleted int64
+	for _, row := range s.rows {
+		if row.PublishedAt != nil && row.PublishedAt.Before(before) {
+			deleted++
+			continue
+		}
+		kept = append(kept, row)
+	}
+	s.rows = kept
+	return deleted, nil
+}
+
+// memoryPublisher records published message bodies, failing on demand
+type memoryPublisher struct {
+	messages []string
+	failOn   string
+}
+
+func (p *memoryPublisher) Publish(ctx context.Context, message interface{}) error {
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	if p.failOn != "" && string(body) == p.failOn {
+		return errors.New("publish not confirmed by broker")
+	}
+	p.messages = append(p.messages, string(body))
+	return nil
+}
+
+func TestRelayOnce_PublishesInOrderAndMarksPublished(t *testing.T) {
+	store := &fakeStore{}
+	store.add("a")
+	store.add("b")
+	publisher := &memoryPublisher{}
+
+	published, err := NewRelay(store, publisher, Config{}, nil).RelayOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RelayOnce() error = %v", err)
+	}
+	if published != 2 {
+		t.Errorf("published = %d, want 2", published)
+	}
+	if len(publisher.messages) != 2 || publisher.messages[0] != `{"id":"a"}` || publisher.messages[1] != `{"id":"b"}` {
+		t.Errorf("messages = %v, want payloads a then b", publisher.messages)
+	}
+	for _, row := range store.rows {
+		if row.PublishedAt == nil {
+			t.Errorf("event %s not marked published", row.EventID)
+		}
+	}
+}
+
+func TestRelayOnce_StopsAtFailureAndRetriesLater(t *testing.T) {
+	store := &fakeStore{}
+	store.add("a")
+	store.add("b")
+	store.add("c")
+	publisher := &memoryPublisher{failOn: `{"id":"b"}`}
+	relay := NewRelay(store, publisher, Config{}, nil)
+
+	published, err := relay.RelayOnce(context.Background())
+	if err == nil {
+		t.Fatal("RelayOnce() error = nil, want publish error")
+	}
+	if published != 1 {
+		t.Errorf("published = %d, want 1", published)
+	}
+	if store.rows[1].LastError == nil || store.rows[1].Attempts != 1 {
+		t.Errorf("failed event not recorded: attempts=%d lastError=%v", store.rows[1].Attempts, store.rows[1].LastError)
+	}
+	if store.rows[2].PublishedAt != nil || store.rows[2].Attempts != 0 {
+		t.Error("event after the failure must not be attempted")
+	}
+
+	// Broker recovers: b and c go out in order on the next poll
+	publisher.failOn = ""
+	published, err = relay.RelayOnce(context.Background())
+	if err != nil {
+		t.Fatalf("RelayOnce() retry error = %v", err)
+	}
+	if published != 2 {
+		t.Errorf("published on retry = %d, want 2", published)
+	}
+	want := []string{`{"id":"a"}`, `{"id":"b"}`, `{"id":"c"}`}
+	for i, msg := range want {
+		if publisher.messages[i] != msg {
+			t.Errorf("messages[%d] = %s, want %s", i, publisher.messages[i], msg)
+		}
+	}
+}
+
+func TestRelay_DrainPublishesAllBatches(t *testing.T) {
+	store := &fakeStore{}
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		store.add(id)
+	}
+	publisher := &memoryPublisher{}
+
+	NewRelay(store, publisher, Config{BatchSize: 2}, nil).drain(context.Background())
+
+	if len(publisher.messages) != 5 {
+		t.Errorf("published %d messages, want 5", len(publisher.messages))
+	}
+}
+
+func TestRelay_PruneDeletesOldPublishedEvents(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-8 * 24 * time.Hour)
+	recent := now.Add(-time.Hour)
+
+	store := &fakeStore{}
+	store.add("old")
+	store.add("recent")
+	store.add("pending")
+	store.rows[0].PublishedAt = &old
+	store.rows[1].PublishedAt = &recent
+
+	relay := NewRelay(store, &memoryPublisher{}, Config{}, nil)
+	relay.now = func() time.Time { return now }
+	relay.prune(context.Background())
+
+	if len(store.rows) != 2 || store.rows[0].EventID != "recent" || store.rows[1].EventID != "pending" {
+		t.Errorf("rows after prune = %d, want recent and pending kept", len(store.rows))
+	}
+}
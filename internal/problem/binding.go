@@ -0,0 +1,152 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+func init() {
+	// Report fields by their JSON names so errors match the request body
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(jsonFieldName)
+	}
+}
+
+// embeddedField names untagged embedded structs, whose fields encoding/json
+// promotes to the parent; fieldPath drops it
+const embeddedField = "~embedded"
+
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	if name == "" && field.Anonymous {
+		return embeddedField
+	}
+	return name
+}
+
+// DescribeBindingError turns an error from ShouldBind* into a detail and
+// field errors without exposing Go type or struct names
+func DescribeBindingError(err error) (string, []FieldError) {
+	var validationErrs validator.ValidationErrors
+	if errors.As(err, &validationErrs) {
+		fields := make([]FieldError, 0, len(validationErrs))
+		for _, fe := range validationErrs {
+			fields = append(fields, FieldError{Field: fieldPath(fe.Namespace()), Message: validationMessage(fe)})
+		}
+		return "request validation failed", fields
+	}
+
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		if typeErr.Field == "" {
+			return "request body " + typeMessage(typeErr.Type), nil
+		}
+		return "request validation failed", []FieldError{{Field: typeErr.Field, Message: typeMessage(typeErr.Type)}}
+	}
+
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.Is(err, io.EOF):
+		return "request body is required", nil
+	case errors.As(err, &syntaxErr), errors.Is(err, io.ErrUnexpectedEOF):
+		return "request body is not valid JSON", nil
+	}
+	return "request body is invalid", nil
+}
+
+// fieldPath drops the struct name and embedded structs from a validator
+// namespace: "Webhook.events[1]" becomes "events[1]"
+func fieldPath(namespace string) string {
+	segments := strings.Split(namespace, ".")
+	if len(segments) == 1 {
+		return namespace
+	}
+	path := make([]string, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		if segment != embeddedField {
+			path = append(path, segment)
+		}
+	}
+	return strings.Join(path, ".")
+}
+
+func validationMessage(fe validator.FieldError) string {
+	param := fe.Param()
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "url":
+		return "must be a valid URL"
+	case "hexcolor":
+		return "must be a hex color such as #1a2b3c"
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(param, " ", ", ")
+	case "min", "gte":
+		return sizeMessage(fe.Kind(), "at least", param)
+	case "max", "lte":
+		return sizeMessage(fe.Kind(), "at most", param)
+	case "gt":
+		return sizeMessage(fe.Kind(), "more than", param)
+	case "lt":
+		return sizeMessage(fe.Kind(), "less than", param)
+	case "len":
+		return sizeMessage(fe.Kind(), "exactly", param)
+	}
+	return "is invalid"
+}
+
+// sizeMessage phrases a bound the way it applies to the field's kind
+func sizeMessage(kind reflect.Kind, bound, param string) string {
+	switch kind {
+	case reflect.String:
+		return fmt.Sprintf("must be %s %s %s long", bound, param, plural(param, "character"))
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return fmt.Sprintf("must contain %s %s %s", bound, param, plural(param, "item"))
+	}
+	return fmt.Sprintf("must be %s %s", bound, param)
+}
+
+func plural(count, noun string) string {
+	if count == "1" {
+		return noun
+	}
+	return noun + "s"
+}
+
+// typeMessage describes the JSON type a Go type expects
+func typeMessage(t reflect.Type) string {
+	if t == nil {
+		return "has the wrong type"
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "must be a string"
+	case reflect.Bool:
+		return "must be true or false"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "must be a whole number"
+	case reflect.Float32, reflect.Float64:
+		return "must be a number"
+	case reflect.Slice, reflect.Array:
+		return "must be an array"
+	case reflect.Struct, reflect.Map:
+		return "must be an object"
+	}
+	return "has the wrong type"
+}
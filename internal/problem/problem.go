@@ -0,0 +1,117 @@
+// Package problem writes RFC 7807 application/problem+json error responses.
+//
+// Every error a handler returns has the same shape: type, title, status,
+// detail, instance (the request path) and the request ID from the logging
+// middleware, so the UI and support can match a response to its log lines.
+// Request validation failures also list the offending JSON fields in errors,
+// with messages meant for people instead of raw validator output.
+package problem
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/GunarsK-portfolio/portfolio-common/logger"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ContentType is the media type of problem responses
+const ContentType = "application/problem+json"
+
+// Problem types. TypeBlank means the status code says everything.
+const (
+	TypeBlank         = "about:blank"
+	TypeValidation    = "urn:portfolio:problem:validation"
+	TypeHasDependents = "urn:portfolio:problem:has-dependents"
+)
+
+// FieldError is a problem with one request field
+type FieldError struct {
+	// Field is the JSON path of the field, e.g. "events[1]" or "fileId"
+	Field   string `json:"field" example:"fileId"`
+	Message string `json:"message" example:"is required"`
+}
+
+// Problem is an RFC 7807 problem details object
+type Problem struct {
+	Type      string       `json:"type" example:"about:blank"`
+	Title     string       `json:"title" example:"Bad Request"`
+	Status    int          `json:"status" example:"400"`
+	Detail    string       `json:"detail,omitempty" example:"invalid id"`
+	Instance  string       `json:"instance,omitempty" example:"/api/v1/portfolio/skills/abc"`
+	RequestID string       `json:"requestId,omitempty" example:"5f0c6f0e-3c1a-4c55-9d8e-2f7a0b1c9e21"`
+	Errors    []FieldError `json:"errors,omitempty"`
+}
+
+// New builds a problem for the current request
+func New(c *gin.Context, status int, detail string) *Problem {
+	return &Problem{
+		Type:      TypeBlank,
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    detail,
+		Instance:  c.Request.URL.Path,
+		RequestID: requestID(c),
+	}
+}
+
+// Write sends p as the response
+func Write(c *gin.Context, p *Problem) {
+	WriteBody(c, p.Status, p)
+}
+
+// WriteBody sends a problem with extension members, i.e. a struct embedding Problem
+func WriteBody(c *gin.Context, status int, body interface{}) {
+	c.Header("Content-Type", ContentType)
+	c.JSON(status, body)
+}
+
+// RespondError responds without logging (for expected errors like invalid input)
+func RespondError(c *gin.Context, status int, detail string) {
+	Write(c, New(c, status, detail))
+}
+
+// LogAndRespondError logs err and responds with detail; err itself is not
+// sent to the client
+func LogAndRespondError(c *gin.Context, status int, err error, detail string) {
+	logger.GetLogger(c).Error(detail,
+		"error", err,
+		"status", status,
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+	)
+	RespondError(c, status, detail)
+}
+
+// HandleRepositoryError responds 404 with notFoundDetail when err is a missing
+// record, otherwise logs it and responds 500 with internalDetail
+func HandleRepositoryError(c *gin.Context, err error, notFoundDetail, internalDetail string) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		RespondError(c, http.StatusNotFound, notFoundDetail)
+		return
+	}
+	LogAndRespondError(c, http.StatusInternalServerError, err, internalDetail)
+}
+
+// RespondFieldErrors responds with a validation problem listing fields
+func RespondFieldErrors(c *gin.Context, status int, detail string, fields ...FieldError) {
+	p := New(c, status, detail)
+	p.Type = TypeValidation
+	p.Errors = fields
+	Write(c, p)
+}
+
+// RespondBindingError responds 400 for an error from ShouldBind*, mapping
+// validator and JSON decoding errors to field errors
+func RespondBindingError(c *gin.Context, err error) {
+	detail, fields := DescribeBindingError(err)
+	RespondFieldErrors(c, http.StatusBadRequest, detail, fields...)
+}
+
+func requestID(c *gin.Context) string {
+	if id := logger.GetRequestID(c.Request.Context()); id != "" {
+		return id
+	}
+	return c.Writer.Header().Get("X-Request-ID")
+}
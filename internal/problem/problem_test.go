@@ -0,0 +1,135 @@
+package problem
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type testBase struct {
+	Title string `json:"title" binding:"required"`
+}
+
+type testRequest struct {
+	testBase
+	Name   string   `json:"name" binding:"required,max=5"`
+	Email  string   `json:"email" binding:"omitempty,email"`
+	Tags   []string `json:"tags" binding:"omitempty,dive,min=2"`
+	Amount int      `json:"amount"`
+}
+
+func bindAndRespond(t *testing.T, body string) (*httptest.ResponseRecorder, Problem) {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/things", func(c *gin.Context) {
+		var req testRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			RespondBindingError(c, err)
+			return
+		}
+		c.Status(http.StatusNoContent)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/things", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	var p Problem
+	if w.Code != http.StatusNoContent {
+		if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+			t.Fatalf("Failed to unmarshal problem: %v", err)
+		}
+	}
+	return w, p
+}
+
+func TestRespondBindingError(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantDetail string
+		wantErrors []FieldError
+	}{
+		{
+			name:       "validation",
+			body:       `{"name":"too long","email":"x","tags":["ok","a"]}`,
+			wantDetail: "request validation failed",
+			wantErrors: []FieldError{
+				{Field: "title", Message: "is required"},
+				{Field: "name", Message: "must be at most 5 characters long"},
+				{Field: "email", Message: "must be a valid email address"},
+				{Field: "tags[1]", Message: "must be at least 2 characters long"},
+			},
+		},
+		{
+			name:       "wrong type",
+			body:       `{"title":"t","name":"ok","amount":"ten"}`,
+			wantDetail: "request validation failed",
+			wantErrors: []FieldError{{Field: "amount", Message: "must be a whole number"}},
+		},
+		{name: "syntax", body: `{"name":`, wantDetail: "request body is not valid JSON"},
+		{name: "empty", body: ``, wantDetail: "request body is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, p := bindAndRespond(t, tt.body)
+
+			if w.Code != http.StatusBadRequest || w.Header().Get("Content-Type") != ContentType {
+				t.Errorf("status = %d, Content-Type = %q, want 400 %s", w.Code, w.Header().Get("Content-Type"), ContentType)
+			}
+			if p.Type != TypeValidation || p.Detail != tt.wantDetail || p.Instance != "/things" {
+				t.Errorf("problem = %+v, want %s with detail %q", p, TypeValidation, tt.wantDetail)
+			}
+			if fmt.Sprint(p.Errors) != fmt.Sprint(tt.wantErrors) {
+				t.Errorf("errors = %+v, want %+v", p.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestHandleRepositoryError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	tests := []struct {
+		err        error
+		wantStatus int
+		wantDetail string
+	}{
+		{gorm.ErrRecordNotFound, http.StatusNotFound, "thing not found"},
+		{errors.New("connection reset"), http.StatusInternalServerError, "failed to fetch thing"},
+	}
+
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/things/1", nil)
+		c.Writer.Header().Set("X-Request-ID", "req-1")
+
+		HandleRepositoryError(c, tt.err, "thing not found", "failed to fetch thing")
+
+		var p Problem
+		if err := json.Unmarshal(w.Body.Bytes(), &p); err != nil {
+			t.Fatalf("Failed to unmarshal problem: %v", err)
+		}
+		want := Problem{
+			Type:      TypeBlank,
+			Title:     http.StatusText(tt.wantStatus),
+			Status:    tt.wantStatus,
+			Detail:    tt.wantDetail,
+			Instance:  "/things/1",
+			RequestID: "req-1",
+		}
+		if w.Code != tt.wantStatus || fmt.Sprint(p) != fmt.Sprint(want) {
+			t.Errorf("HandleRepositoryError(%v) = %d %+v, want %+v", tt.err, w.Code, p, want)
+		}
+	}
+}
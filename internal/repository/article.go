@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/portfolio-common/utils"
+)
+
+// ErrUnknownSkills is returned when linking skill IDs that do not exist
+var ErrUnknownSkills = errors.New("unknown skills")
+
+func (r *repository) GetAllArticles(ctx context.Context, filter models.ArticleFilter) ([]models.Article, error) {
+	query := r.db.WithContext(ctx).
+		Preload("CoverFile").
+		Preload("Skills.Skill").
+		Scopes(taggedWith(models.EntityArticle, filter.Tag))
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var articles []models.Article
+	// Drafts have no publication date and come first
+	if err := query.Order("published_at DESC NULLS FIRST, created_at DESC").Find(&articles).Error; err != nil {
+		return nil, fmt.Errorf("failed to get all articles: %w", err)
+	}
+	if err := r.fillArticles(ctx, articles); err != nil {
+		return nil, err
+	}
+	return articles, nil
+}
+
+func (r *repository) GetArticleByID(ctx context.Context, id int64) (*models.Article, error) {
+	var article models.Article
+	err := r.db.WithContext(ctx).
+		Preload("CoverFile").
+		Preload("Skills.Skill").
+		First(&article, id).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get article with id %d: %w", id, err)
+	}
+	articles := []models.Article{article}
+	if err := r.fillArticles(ctx, articles); err != nil {
+		return nil, err
+	}
+	return &articles[0], nil
+}
+
+// CreateArticle creates an article linked to skillIDs and gives it a slug
+// derived from its title
+func (r *repository) CreateArticle(ctx context.Context, article *models.Article, skillIDs []int64) error {
+	return r.withOutbox(ctx, models.EntityArticle, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt", "CoverFile", "Skills").Create(article).Error; err != nil {
+			return 0, fmt.Errorf("failed to create article: %w", err)
+		}
+		if err := tx.linkArticleSkills(ctx, article.ID, skillIDs); err != nil {
+			return article.ID, err
+		}
+		return article.ID, tx.ensureSlug(ctx, models.EntityArticle, article.ID)
+	})
+}
+
+// UpdateArticle updates an article and replaces its skills with skillIDs
+func (r *repository) UpdateArticle(ctx context.Context, article *models.Article, skillIDs []int64) error {
+	return r.withOutbox(ctx, models.EntityArticle, events.ActionUpdated, func(tx *repository) (int64, error) {
+		if err := tx.safeUpdate(ctx, article, article.ID); err != nil {
+			return article.ID, err
+		}
+		if err := tx.linkArticleSkills(ctx, article.ID, skillIDs); err != nil {
+			return article.ID, err
+		}
+		return article.ID, tx.ensureSlug(ctx, models.EntityArticle, article.ID)
+	})
+}
+
+// DeleteArticle deletes an article and automatically cascades to:
+// - blog.article_skills (links to skills)
+// Its portfolio.taggings, portfolio.slugs and portfolio.seo_metadata rows are
+// removed explicitly (no foreign key).
+// Note: The cover file in storage.files is NOT deleted (purged later via POST /files/orphans/purge)
+func (r *repository) DeleteArticle(ctx context.Context, id int64) error {
+	return r.withOutbox(ctx, models.EntityArticle, events.ActionDeleted, func(tx *repository) (int64, error) {
+		if err := tx.detach(ctx, models.EntityArticle, id); err != nil {
+			return id, err
+		}
+		return id, checkRowsAffected(tx.db.WithContext(ctx).Delete(&models.Article{}, id))
+	})
+}
+
+// SetArticleSkills replaces all skills linked to an article
+func (r *repository) SetArticleSkills(ctx context.Context, articleID int64, skillIDs []int64) error {
+	return r.withOutbox(ctx, models.EntityArticle, events.ActionUpdated, func(tx *repository) (int64, error) {
+		if err := tx.checkRecord(ctx, models.EntityArticle, &models.Article{}, articleID); err != nil {
+			return articleID, err
+		}
+		return articleID, tx.linkArticleSkills(ctx, articleID, skillIDs)
+	})
+}
+
+// linkArticleSkills replaces the skill links of an article. Unknown skill IDs
+// fail with ErrUnknownSkills; duplicates are linked once.
+func (r *repository) linkArticleSkills(ctx context.Context, articleID int64, skillIDs []int64) error {
+	unique := make([]int64, 0, len(skillIDs))
+	seen := make(map[int64]bool, len(skillIDs))
+	for _, skillID := range skillIDs {
+		if !seen[skillID] {
+			seen[skillID] = true
+			unique = append(unique, skillID)
+		}
+	}
+	db := r.db.WithContext(ctx)
+	if len(unique) > 0 {
+		var count int64
+		if err := db.Model(&models.Skill{}).Where("id IN ?", unique).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check skills: %w", err)
+		}
+		if int(count) != len(unique) {
+			return fmt.Errorf("%w: %d of %d skills do not exist", ErrUnknownSkills, len(unique)-int(count), len(unique))
+		}
+	}
+
+	// Delete existing skills
+	if err := db.Where("article_id = ?", articleID).Delete(&models.ArticleSkill{}).Error; err != nil {
+		return fmt.Errorf("failed to clear skills: %w", err)
+	}
+
+	// Insert new skills
+	for _, skillID := range unique {
+		link := models.ArticleSkill{ArticleID: articleID, SkillID: skillID}
+		if err := db.Omit("ID", "CreatedAt", "Skill").Create(&link).Error; err != nil {
+			return fmt.Errorf("failed to add skill %d: %w", skillID, err)
+		}
+	}
+	return nil
+}
+
+// fillArticles sets the cover URL, current slug and tag names of articles
+func (r *repository) fillArticles(ctx context.Context, articles []models.Article) error {
+	if len(articles) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(articles))
+	index := make(map[int64]int, len(articles))
+	for i := range articles {
+		ids[i] = articles[i].ID
+		index[articles[i].ID] = i
+		utils.PopulateFileURL(articles[i].CoverFile, r.filesAPIURL)
+	}
+	db := r.db.WithContext(ctx)
+
+	var slugs []models.Slug
+	if err := db.Where("entity = ? AND entity_id IN ? AND is_current", models.EntityArticle, ids).Find(&slugs).Error; err != nil {
+		return fmt.Errorf("failed to get slugs of articles: %w", err)
+	}
+	for _, slug := range slugs {
+		articles[index[slug.EntityID]].Slug = slug.Slug
+	}
+
+	var tags []struct {
+		EntityID int64
+		Name     string
+	}
+	err := db.Table("portfolio.taggings tg").
+		Select("tg.entity_id, t.name").
+		Joins("JOIN portfolio.tags t ON t.id = tg.tag_id").
+		Where("tg.entity = ? AND tg.entity_id IN ?", models.EntityArticle, ids).
+		Order("lower(t.name) ASC").
+		Scan(&tags).Error
+	if err != nil {
+		return fmt.Errorf("failed to get tags of articles: %w", err)
+	}
+	for _, tag := range tags {
+		i := index[tag.EntityID]
+		articles[i].Tags = append(articles[i].Tags, tag.Name)
+	}
+	return nil
+}
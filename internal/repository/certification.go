@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 )
 
@@ -26,16 +27,39 @@ func (r *repository) GetCertificationByID(ctx context.Context, id int64) (*model
 }
 
 func (r *repository) CreateCertification(ctx context.Context, cert *models.Certification) error {
-	if err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(cert).Error; err != nil {
-		return fmt.Errorf("failed to create certification: %w", err)
-	}
-	return nil
+	return r.withOutbox(ctx, models.EntityCertification, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(cert).Error; err != nil {
+			return 0, fmt.Errorf("failed to create certification: %w", err)
+		}
+		return cert.ID, nil
+	})
 }
 
 func (r *repository) UpdateCertification(ctx context.Context, cert *models.Certification) error {
-	return r.safeUpdate(ctx, cert, cert.ID)
+	return r.withOutbox(ctx, models.EntityCertification, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return cert.ID, tx.safeUpdate(ctx, cert, cert.ID)
+	})
 }
 
 func (r *repository) DeleteCertification(ctx context.Context, id int64) error {
-	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.Certification{}, id))
+	return r.withOutbox(ctx, models.EntityCertification, events.ActionDeleted, func(tx *repository) (int64, error) {
+		return id, checkRowsAffected(tx.db.WithContext(ctx).Delete(&models.Certification{}, id))
+	})
+}
+
+// GetCertificationReminders returns all reminders sent so far
+func (r *repository) GetCertificationReminders(ctx context.Context) ([]models.CertificationReminder, error) {
+	var reminders []models.CertificationReminder
+	if err := r.db.WithContext(ctx).Find(&reminders).Error; err != nil {
+		return nil, fmt.Errorf("failed to get certification reminders: %w", err)
+	}
+	return reminders, nil
+}
+
+// CreateCertificationReminder records a sent reminder
+func (r *repository) CreateCertificationReminder(ctx context.Context, reminder *models.CertificationReminder) error {
+	if err := r.db.WithContext(ctx).Omit("ID").Create(reminder).Error; err != nil {
+		return fmt.Errorf("failed to record certification reminder: %w", err)
+	}
+	return nil
 }
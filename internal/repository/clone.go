@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"gorm.io/gorm/clause"
+)
+
+// Link rows copied to a clone. Statements use the named parameters @id (the
+// source record) and @clone (the new record).
+var (
+	miniatureCloneLinks = []string{
+		`INSERT INTO miniatures.miniature_techniques (miniature_project_id, technique_id, notes)
+			SELECT @clone, technique_id, notes FROM miniatures.miniature_techniques WHERE miniature_project_id = @id ORDER BY id`,
+		`INSERT INTO miniatures.miniature_paints (miniature_project_id, paint_id, usage_notes)
+			SELECT @clone, paint_id, usage_notes FROM miniatures.miniature_paints WHERE miniature_project_id = @id ORDER BY id`,
+		`INSERT INTO portfolio.taggings (tag_id, entity, entity_id)
+			SELECT tag_id, entity, @clone FROM portfolio.taggings WHERE entity = 'miniature_project' AND entity_id = @id`,
+		`INSERT INTO portfolio.translations (entity, entity_id, field, locale, value)
+			SELECT entity, @clone, field, locale, value FROM portfolio.translations WHERE entity = 'miniature_project' AND entity_id = @id`,
+	}
+	miniatureCloneImages = `INSERT INTO miniatures.miniature_files (miniature_project_id, file_id, caption, display_order)
+		SELECT @clone, file_id, caption, display_order FROM miniatures.miniature_files WHERE miniature_project_id = @id ORDER BY id`
+	portfolioCloneLinks = []string{
+		`INSERT INTO portfolio.project_technologies (project_id, skill_id)
+			SELECT @clone, skill_id FROM portfolio.project_technologies WHERE project_id = @id`,
+		`INSERT INTO portfolio.taggings (tag_id, entity, entity_id)
+			SELECT tag_id, entity, @clone FROM portfolio.taggings WHERE entity = 'portfolio_project' AND entity_id = @id`,
+		`INSERT INTO portfolio.translations (entity, entity_id, field, locale, value)
+			SELECT entity, @clone, field, locale, value FROM portfolio.translations WHERE entity = 'portfolio_project' AND entity_id = @id`,
+	}
+)
+
+// CloneMiniatureProject copies a miniature with its theme, tags, translations,
+// techniques and paints (with notes). The copy is a new, unfinished miniature: the
+// completion date and time spent are cleared so it does not count in the stats,
+// and it is hidden from the public site until it is edited and shown.
+func (r *repository) CloneMiniatureProject(ctx context.Context, id int64, opts models.CloneOptions) (*models.MiniatureProject, error) {
+	var cloneID int64
+	err := r.withOutbox(ctx, models.EntityMiniatureProject, events.ActionCreated, func(tx *repository) (int64, error) {
+		var source models.MiniatureProject
+		if err := tx.db.WithContext(ctx).First(&source, id).Error; err != nil {
+			return 0, fmt.Errorf("failed to get miniature project with id %d: %w", id, err)
+		}
+
+		clone := models.MiniatureProject{
+			ThemeID:      source.ThemeID,
+			Title:        source.Title + models.CloneSuffix,
+			Description:  source.Description,
+			Scale:        source.Scale,
+			Manufacturer: source.Manufacturer,
+			Difficulty:   source.Difficulty,
+			DisplayOrder: source.DisplayOrder,
+		}
+		links := miniatureCloneLinks
+		if opts.IncludeImages {
+			links = append(links[:len(links):len(links)], miniatureCloneImages)
+		}
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt", clause.Associations).Create(&clone).Error; err != nil {
+			return 0, fmt.Errorf("failed to clone miniature project %d: %w", id, err)
+		}
+		if err := tx.copyLinks(ctx, id, clone.ID, links); err != nil {
+			return clone.ID, fmt.Errorf("failed to copy links of miniature project %d: %w", id, err)
+		}
+		if err := tx.setVisibility(ctx, models.EntityMiniatureProject, clone.ID, false); err != nil {
+			return clone.ID, fmt.Errorf("failed to hide clone of miniature project %d: %w", id, err)
+		}
+		if err := tx.ensureSlug(ctx, models.EntityMiniatureProject, clone.ID); err != nil {
+			return clone.ID, err
+		}
+		cloneID = clone.ID
+		return clone.ID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetMiniatureProjectByID(ctx, cloneID)
+}
+
+// ClonePortfolioProject copies a portfolio project with its technologies,
+// tags and translations. The copy is hidden from the public site and never
+// featured, and keeps the image only when asked to.
+func (r *repository) ClonePortfolioProject(ctx context.Context, id int64, opts models.CloneOptions) (*models.PortfolioProject, error) {
+	var cloneID int64
+	err := r.withOutbox(ctx, models.EntityPortfolioProject, events.ActionCreated, func(tx *repository) (int64, error) {
+		var source models.PortfolioProject
+		if err := tx.db.WithContext(ctx).First(&source, id).Error; err != nil {
+			return 0, fmt.Errorf("failed to get portfolio project with id %d: %w", id, err)
+		}
+
+		clone := source
+		clone.ID = 0
+		clone.Title = source.Title + models.CloneSuffix
+		clone.Featured = false
+		if !opts.IncludeImages {
+			clone.ImageFileID = nil
+		}
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt", clause.Associations).Create(&clone).Error; err != nil {
+			return 0, fmt.Errorf("failed to clone portfolio project %d: %w", id, err)
+		}
+		if err := tx.copyLinks(ctx, id, clone.ID, portfolioCloneLinks); err != nil {
+			return clone.ID, fmt.Errorf("failed to copy technologies of portfolio project %d: %w", id, err)
+		}
+		if err := tx.setVisibility(ctx, models.EntityPortfolioProject, clone.ID, false); err != nil {
+			return clone.ID, fmt.Errorf("failed to hide clone of portfolio project %d: %w", id, err)
+		}
+		if err := tx.ensureSlug(ctx, models.EntityPortfolioProject, clone.ID); err != nil {
+			return clone.ID, err
+		}
+		cloneID = clone.ID
+		return clone.ID, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.GetPortfolioProjectByID(ctx, cloneID)
+}
+
+// copyLinks copies the link rows of source id to the clone
+func (r *repository) copyLinks(ctx context.Context, id, cloneID int64, links []string) error {
+	params := map[string]interface{}{"id": id, "clone": cloneID}
+	for _, statement := range links {
+		if err := r.db.WithContext(ctx).Exec(statement, params).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
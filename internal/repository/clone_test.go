@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// recordingDriver is a database/sql driver that records every statement.
+// Inserts return cloneID, reads of table return one record and every other
+// query returns no rows.
+type recordingDriver struct {
+	mu         sync.Mutex
+	statements []recordedStatement
+	table      string
+	cloneID    int64
+}
+
+type recordedStatement struct {
+	query string
+	args  []driver.NamedValue
+}
+
+func (d *recordingDriver) Open(string) (driver.Conn, error) { return &recordingConn{d}, nil }
+
+func (d *recordingDriver) record(query string, args []driver.NamedValue) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.statements = append(d.statements, recordedStatement{query, args})
+}
+
+type recordingConn struct{ d *recordingDriver }
+
+func (c *recordingConn) Prepare(string) (driver.Stmt, error) { return nil, driver.ErrSkip }
+func (c *recordingConn) Close() error                        { return nil }
+func (c *recordingConn) Begin() (driver.Tx, error)           { c.d.record("BEGIN", nil); return c, nil }
+func (c *recordingConn) Commit() error                       { c.d.record("COMMIT", nil); return nil }
+func (c *recordingConn) Rollback() error                     { c.d.record("ROLLBACK", nil); return nil }
+
+func (c *recordingConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.d.record(query, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (c *recordingConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.d.record(query, args)
+	switch {
+	case strings.HasPrefix(query, "INSERT"):
+		return &recordingRows{columns: []string{"id"}, values: [][]driver.Value{{c.d.cloneID}}}, nil
+	case strings.HasPrefix(query, "SELECT * FROM "+c.d.table):
+		return &recordingRows{columns: []string{"id", "title"}, values: [][]driver.Value{{args[0].Value, "Order pipeline"}}}, nil
+	}
+	return &recordingRows{}, nil
+}
+
+type recordingRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *recordingRows) Columns() []string { return r.columns }
+func (r *recordingRows) Close() error      { return nil }
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+func newRecordingRepository(t *testing.T, d *recordingDriver) *repository {
+	t.Helper()
+	name := "recording-" + t.Name()
+	sql.Register(name, d)
+	sqlDB, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	return &repository{db: db}
+}
+
+func TestClone_StartsHidden(t *testing.T) {
+	tests := []struct {
+		name  string
+		table string
+		clone func(r *repository) error
+	}{
+		{"miniature project", `"miniatures"."miniature_projects"`, func(r *repository) error {
+			_, err := r.CloneMiniatureProject(context.Background(), 3, models.CloneOptions{})
+			return err
+		}},
+		{"portfolio project", `"portfolio"."portfolio_projects"`, func(r *repository) error {
+			_, err := r.ClonePortfolioProject(context.Background(), 3, models.CloneOptions{IncludeImages: true})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &recordingDriver{table: tt.table, cloneID: 7}
+			if err := tt.clone(newRecordingRepository(t, d)); err != nil {
+				t.Fatalf("clone error = %v", err)
+			}
+
+			hidden := false
+			for _, s := range d.statements {
+				if s.query == "COMMIT" {
+					break
+				}
+				if strings.HasPrefix(s.query, "UPDATE "+tt.table+` SET "is_visible"=`) &&
+					len(s.args) == 2 && s.args[0].Value == false && s.args[1].Value == int64(7) {
+					hidden = true
+				}
+			}
+			if !hidden {
+				t.Errorf("clone 7 was not hidden in the clone transaction, statements: %+v", d.statements)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// GetDashboard counts records per entity and runs the content quality checks.
+// Certifications expiring within expiringWithinDays (from today) are reported.
+func (r *repository) GetDashboard(ctx context.Context, expiringWithinDays int) (*models.Dashboard, error) {
+	db := r.db.WithContext(ctx)
+
+	dashboard := &models.Dashboard{
+		Counts:   []models.EntityCount{},
+		Findings: []models.ContentFinding{},
+	}
+
+	// Record counts
+	for _, entity := range []struct {
+		name  string
+		model interface{}
+	}{
+		{models.EntityProfile, &models.Profile{}},
+		{models.EntityWorkExperience, &models.WorkExperience{}},
+		{models.EntityCertification, &models.Certification{}},
+		{models.EntityEducation, &models.Education{}},
+		{models.EntityTestimonial, &models.Testimonial{}},
+		{models.EntitySkill, &models.Skill{}},
+		{models.EntitySkillType, &models.SkillType{}},
+		{models.EntityPortfolioProject, &models.PortfolioProject{}},
+		{models.EntityMiniatureTheme, &models.MiniatureTheme{}},
+		{models.EntityMiniatureProject, &models.MiniatureProject{}},
+		{models.EntityMiniaturePaint, &models.MiniaturePaint{}},
+		{models.EntityMiniatureTechnique, &models.MiniatureTechnique{}},
+		{models.EntityArticle, &models.Article{}},
+	} {
+		var count int64
+		if err := db.Model(entity.model).Count(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to count %s records: %w", entity.name, err)
+		}
+		dashboard.Counts = append(dashboard.Counts, models.EntityCount{Entity: entity.name, Count: count})
+	}
+
+	// Content quality checks. Each query selects entity_id, title and detail.
+	checks := []struct {
+		check  string
+		entity string
+		query  *gorm.DB
+	}{
+		{
+			models.CheckProjectMissingImage, models.EntityPortfolioProject,
+			db.Table("portfolio.portfolio_projects AS p").
+				Select("p.id AS entity_id, p.title AS title, '' AS detail").
+				Where("p.image_file_id IS NULL").
+				Order("p.display_order ASC, p.id ASC"),
+		},
+		{
+			models.CheckProjectMissingLinks, models.EntityPortfolioProject,
+			db.Table("portfolio.portfolio_projects AS p").
+				Select("p.id AS entity_id, p.title AS title, 'no githubUrl or liveUrl' AS detail").
+				Where("COALESCE(p.github_url, '') = '' AND COALESCE(p.live_url, '') = ''").
+				Order("p.display_order ASC, p.id ASC"),
+		},
+		{
+			models.CheckExperienceMissingDesc, models.EntityWorkExperience,
+			db.Table("portfolio.work_experience AS w").
+				Select("w.id AS entity_id, w.company || ' - ' || w.position AS title, '' AS detail").
+				Where("TRIM(COALESCE(w.description, '')) = ''").
+				Order("w.start_date DESC, w.id ASC"),
+		},
+		{
+			models.CheckSkillUnused, models.EntitySkill,
+			db.Table("portfolio.skills AS s").
+				Select("s.id AS entity_id, s.skill AS title, '' AS detail").
+				Where("NOT EXISTS (SELECT 1 FROM portfolio.project_technologies pt WHERE pt.skill_id = s.id)").
+				Where("NOT EXISTS (SELECT 1 FROM blog.article_skills a WHERE a.skill_id = s.id)").
+				Order("s.display_order ASC, s.skill ASC"),
+		},
+		{
+			models.CheckCertificationExpiring, models.EntityCertification,
+			db.Table("portfolio.certifications AS c").
+				Select("c.id AS entity_id, c.name AS title, 'expires on ' || c.expiry_date::text AS detail").
+				Where("c.expiry_date >= CURRENT_DATE AND c.expiry_date <= CURRENT_DATE + ?::int", expiringWithinDays).
+				Order("c.expiry_date ASC, c.id ASC"),
+		},
+		{
+			models.CheckMiniatureProjectNoImages, models.EntityMiniatureProject,
+			db.Table("miniatures.miniature_projects AS m").
+				Select("m.id AS entity_id, m.title AS title, '' AS detail").
+				Where("NOT EXISTS (SELECT 1 FROM miniatures.miniature_files mf WHERE mf.miniature_project_id = m.id)").
+				Order("m.display_order ASC, m.id ASC"),
+		},
+		{
+			models.CheckMiniatureThemeMissingCover, models.EntityMiniatureTheme,
+			db.Table("miniatures.miniature_themes AS t").
+				Select("t.id AS entity_id, t.name AS title, '' AS detail").
+				Where("t.cover_image_id IS NULL").
+				Order("t.display_order ASC, t.name ASC"),
+		},
+	}
+
+	for _, check := range checks {
+		var findings []models.ContentFinding
+		if err := check.query.Scan(&findings).Error; err != nil {
+			return nil, fmt.Errorf("failed to run %s check: %w", check.check, err)
+		}
+		for i := range findings {
+			findings[i].Check = check.check
+			findings[i].Entity = check.entity
+		}
+		dashboard.Findings = append(dashboard.Findings, findings...)
+	}
+
+	return dashboard, nil
+}
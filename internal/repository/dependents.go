@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// foreignKeyViolation is the Postgres SQLSTATE for a foreign key violation
+const foreignKeyViolation = "23503"
+
+// ErrHasDependents is matched by every *DependentsError
+var ErrHasDependents = errors.New("record is still referenced")
+
+// ErrInvalidReassignTarget is returned when DeleteOptions.ReassignTo is the
+// record being deleted or does not exist
+var ErrInvalidReassignTarget = errors.New("invalid reassign target")
+
+// DependentsError is returned when a delete is blocked by records that still
+// reference the entity. Dependents is empty when the block was only detected
+// by a foreign key violation.
+type DependentsError struct {
+	Entity     string
+	ID         int64
+	Dependents []models.Dependent
+	Err        error
+}
+
+func (e *DependentsError) Error() string {
+	if len(e.Dependents) == 0 {
+		return fmt.Sprintf("%s %d is still referenced by other records", e.Entity, e.ID)
+	}
+	return fmt.Sprintf("%s %d is still referenced by %d records", e.Entity, e.ID, len(e.Dependents))
+}
+
+// Is reports whether target is ErrHasDependents
+func (e *DependentsError) Is(target error) bool {
+	return target == ErrHasDependents
+}
+
+func (e *DependentsError) Unwrap() error {
+	return e.Err
+}
+
+// deleteRule describes the records that reference an entity and how
+// DeleteOptions resolves them. Statements use the named parameters @id (the
+// entity being deleted) and @target (DeleteOptions.ReassignTo).
+type deleteRule struct {
+	entity string
+	model  interface{}
+	// dependentsEntity and dependentsSQL list the referencing records (id,
+	// name). A query that lists records of several kinds selects their entity
+	// as well; dependentsEntity applies to rows without one.
+	dependentsEntity string
+	dependentsSQL    string
+	cascade          []string
+	reassign         []reassignment
+}
+
+// reassignment moves the references held in one table from @id to @target.
+// For link tables, dedupe first drops the rows that would duplicate a link the
+// target already has.
+type reassignment struct {
+	table  string
+	dedupe string
+	update string
+}
+
+// exec runs the reassignment and reports how many rows were dropped as
+// duplicates and how many were repointed
+func (a reassignment) exec(db *gorm.DB, params map[string]interface{}) (deduped, repointed int64, err error) {
+	if a.dedupe != "" {
+		result := db.Exec(a.dedupe, params)
+		if result.Error != nil {
+			return 0, 0, result.Error
+		}
+		deduped = result.RowsAffected
+	}
+	result := db.Exec(a.update, params)
+	if result.Error != nil {
+		return 0, 0, result.Error
+	}
+	return deduped, result.RowsAffected, nil
+}
+
+var (
+	// Skills require a type, so cascading deletes them with their project and
+	// article links
+	skillTypeDeleteRule = deleteRule{
+		entity:           models.EntitySkillType,
+		model:            &models.SkillType{},
+		dependentsEntity: models.EntitySkill,
+		dependentsSQL:    `SELECT id, skill AS name FROM portfolio.skills WHERE skill_type_id = @id ORDER BY id`,
+		cascade: []string{
+			`DELETE FROM portfolio.project_technologies WHERE skill_id IN (SELECT id FROM portfolio.skills WHERE skill_type_id = @id)`,
+			`DELETE FROM blog.article_skills WHERE skill_id IN (SELECT id FROM portfolio.skills WHERE skill_type_id = @id)`,
+			`DELETE FROM portfolio.skills WHERE skill_type_id = @id`,
+		},
+		reassign: []reassignment{{
+			table:  "portfolio.skills",
+			update: `UPDATE portfolio.skills SET skill_type_id = @target WHERE skill_type_id = @id`,
+		}},
+	}
+	skillDeleteRule = deleteRule{
+		entity:           models.EntitySkill,
+		model:            &models.Skill{},
+		dependentsEntity: models.EntityPortfolioProject,
+		dependentsSQL: `SELECT * FROM (
+			SELECT 'portfolio_project' AS entity, p.id, p.title AS name FROM portfolio.portfolio_projects p
+			JOIN portfolio.project_technologies pt ON pt.project_id = p.id WHERE pt.skill_id = @id
+			UNION ALL
+			SELECT 'article' AS entity, a.id, a.title AS name FROM blog.articles a
+			JOIN blog.article_skills s ON s.article_id = a.id WHERE s.skill_id = @id
+		) d ORDER BY d.entity DESC, d.id`,
+		cascade: []string{
+			`DELETE FROM portfolio.project_technologies WHERE skill_id = @id`,
+			`DELETE FROM blog.article_skills WHERE skill_id = @id`,
+		},
+		reassign: []reassignment{{
+			table: "portfolio.project_technologies",
+			// Projects that already use the target keep a single link
+			dedupe: `DELETE FROM portfolio.project_technologies pt WHERE pt.skill_id = @id AND EXISTS (
+				SELECT 1 FROM portfolio.project_technologies o WHERE o.project_id = pt.project_id AND o.skill_id = @target)`,
+			update: `UPDATE portfolio.project_technologies SET skill_id = @target WHERE skill_id = @id`,
+		}, {
+			table: "blog.article_skills",
+			dedupe: `DELETE FROM blog.article_skills s WHERE s.skill_id = @id AND EXISTS (
+				SELECT 1 FROM blog.article_skills o WHERE o.article_id = s.article_id AND o.skill_id = @target)`,
+			update: `UPDATE blog.article_skills SET skill_id = @target WHERE skill_id = @id`,
+		}},
+	}
+	paintDeleteRule = deleteRule{
+		entity:           models.EntityMiniaturePaint,
+		model:            &models.MiniaturePaint{},
+		dependentsEntity: models.EntityMiniatureProject,
+		dependentsSQL: `SELECT p.id, p.title AS name FROM miniatures.miniature_projects p
+			JOIN miniatures.miniature_paints mp ON mp.miniature_project_id = p.id
+			WHERE mp.paint_id = @id ORDER BY p.id`,
+		cascade: []string{
+			`DELETE FROM miniatures.miniature_paints WHERE paint_id = @id`,
+		},
+		reassign: []reassignment{{
+			table: "miniatures.miniature_paints",
+			dedupe: `DELETE FROM miniatures.miniature_paints mp WHERE mp.paint_id = @id AND EXISTS (
+				SELECT 1 FROM miniatures.miniature_paints o WHERE o.miniature_project_id = mp.miniature_project_id AND o.paint_id = @target)`,
+			update: `UPDATE miniatures.miniature_paints SET paint_id = @target WHERE paint_id = @id`,
+		}},
+	}
+	// Miniatures outlive their theme: cascading only clears theme_id
+	themeDeleteRule = deleteRule{
+		entity:           models.EntityMiniatureTheme,
+		model:            &models.MiniatureTheme{},
+		dependentsEntity: models.EntityMiniatureProject,
+		dependentsSQL:    `SELECT id, title AS name FROM miniatures.miniature_projects WHERE theme_id = @id ORDER BY id`,
+		cascade: []string{
+			`UPDATE miniatures.miniature_projects SET theme_id = NULL WHERE theme_id = @id`,
+		},
+		reassign: []reassignment{{
+			table:  "miniatures.miniature_projects",
+			update: `UPDATE miniatures.miniature_projects SET theme_id = @target WHERE theme_id = @id`,
+		}},
+	}
+)
+
+// deleteWithRule deletes an entity described by rule. Without options,
+// existing dependents block the delete with a *DependentsError; otherwise they
+// are cascaded or reassigned in the same transaction.
+func (r *repository) deleteWithRule(ctx context.Context, rule deleteRule, id int64, opts models.DeleteOptions) error {
+	return r.withOutbox(ctx, rule.entity, events.ActionDeleted, func(tx *repository) (int64, error) {
+		db := tx.db.WithContext(ctx)
+		params := map[string]interface{}{"id": id}
+
+		var deps []models.Dependent
+		if err := db.Raw(rule.dependentsSQL, params).Scan(&deps).Error; err != nil {
+			return id, fmt.Errorf("failed to list dependents of %s %d: %w", rule.entity, id, err)
+		}
+
+		if len(deps) > 0 {
+			switch {
+			case opts.ReassignTo != nil:
+				if err := tx.checkReassignTarget(ctx, rule.model, id, *opts.ReassignTo); err != nil {
+					return id, err
+				}
+				params["target"] = *opts.ReassignTo
+				for _, a := range rule.reassign {
+					if _, _, err := a.exec(db, params); err != nil {
+						return id, fmt.Errorf("failed to reassign dependents of %s %d: %w", rule.entity, id, err)
+					}
+				}
+			case opts.Cascade:
+				for _, statement := range rule.cascade {
+					if err := db.Exec(statement, params).Error; err != nil {
+						return id, fmt.Errorf("failed to cascade delete of %s %d: %w", rule.entity, id, err)
+					}
+				}
+			default:
+				for i := range deps {
+					if deps[i].Entity == "" {
+						deps[i].Entity = rule.dependentsEntity
+					}
+				}
+				return id, &DependentsError{Entity: rule.entity, ID: id, Dependents: deps}
+			}
+		}
+
+		if err := tx.detach(ctx, rule.entity, id); err != nil {
+			return id, err
+		}
+		return id, checkRowsAffected(db.Delete(rule.model, id))
+	})
+}
+
+// detach removes the taggings, slugs, SEO metadata and translations of a
+// record being deleted. They are polymorphic, so no foreign key cascades them.
+func (r *repository) detach(ctx context.Context, entity string, id int64) error {
+	if err := r.untag(ctx, entity, id); err != nil {
+		return err
+	}
+	if err := r.unslug(ctx, entity, id); err != nil {
+		return err
+	}
+	if err := r.dropSEO(ctx, entity, id); err != nil {
+		return err
+	}
+	return r.untranslate(ctx, entity, id)
+}
+
+// checkReassignTarget verifies that target is another existing record of model's table
+func (r *repository) checkReassignTarget(ctx context.Context, model interface{}, id, target int64) error {
+	if target == id {
+		return fmt.Errorf("%w: cannot reassign to the record being deleted", ErrInvalidReassignTarget)
+	}
+	var count int64
+	if err := r.db.WithContext(ctx).Model(model).Where("id = ?", target).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check reassign target: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: %d does not exist", ErrInvalidReassignTarget, target)
+	}
+	return nil
+}
+
+// asDependentsError turns a foreign key violation from deleting entity id
+// into a *DependentsError; other errors are returned unchanged
+func asDependentsError(entity string, id int64, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return &DependentsError{Entity: entity, ID: id, Err: err}
+	}
+	return err
+}
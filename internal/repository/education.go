@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+func (r *repository) GetAllEducation(ctx context.Context) ([]models.Education, error) {
+	var education []models.Education
+	err := r.db.WithContext(ctx).Order("display_order ASC, start_date DESC").Find(&education).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all education: %w", err)
+	}
+	return education, nil
+}
+
+func (r *repository) GetEducationByID(ctx context.Context, id int64) (*models.Education, error) {
+	var education models.Education
+	err := r.db.WithContext(ctx).First(&education, id).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get education with id %d: %w", id, err)
+	}
+	return &education, nil
+}
+
+func (r *repository) CreateEducation(ctx context.Context, education *models.Education) error {
+	return r.withOutbox(ctx, models.EntityEducation, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(education).Error; err != nil {
+			return 0, fmt.Errorf("failed to create education: %w", err)
+		}
+		return education.ID, nil
+	})
+}
+
+func (r *repository) UpdateEducation(ctx context.Context, education *models.Education) error {
+	return r.withOutbox(ctx, models.EntityEducation, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return education.ID, tx.safeUpdate(ctx, education, education.ID)
+	})
+}
+
+func (r *repository) DeleteEducation(ctx context.Context, id int64) error {
+	return r.withOutbox(ctx, models.EntityEducation, events.ActionDeleted, func(tx *repository) (int64, error) {
+		return id, checkRowsAffected(tx.db.WithContext(ctx).Delete(&models.Education{}, id))
+	})
+}
@@ -4,16 +4,47 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 )
 
 // DeleteImage deletes a miniature file record (junction table entry)
 // NOTE: This deletes the link between a miniature and a file, not the actual file in S3
-// The actual file in storage.files remains (can be cleaned up separately if orphaned)
+// The actual file in storage.files remains until purged via POST /files/orphans/purge
 func (r *repository) DeleteImage(ctx context.Context, id int64) error {
-	err := r.db.WithContext(ctx).Delete(&models.MiniatureFile{}, id).Error
+	return r.withOutbox(ctx, models.EntityFile, events.ActionDeleted, func(tx *repository) (int64, error) {
+		return id, checkRowsAffected(tx.db.WithContext(ctx).Delete(&models.MiniatureFile{}, id))
+	})
+}
+
+// GetStorageFileByID returns the metadata of a file in storage.files
+func (r *repository) GetStorageFileByID(ctx context.Context, id int64) (*models.StorageFile, error) {
+	var file models.StorageFile
+	if err := r.db.WithContext(ctx).First(&file, id).Error; err != nil {
+		return nil, fmt.Errorf("failed to get storage file with id %d: %w", id, err)
+	}
+	return &file, nil
+}
+
+// GetOrphanedFiles returns storage files no longer referenced by the profile,
+// a portfolio project, a testimonial avatar, a miniature theme cover, a
+// miniature gallery, SEO metadata or an article cover, oldest first
+func (r *repository) GetOrphanedFiles(ctx context.Context) ([]models.StorageFile, error) {
+	var files []models.StorageFile
+	err := r.db.WithContext(ctx).
+		Table("storage.files AS f").
+		Select("f.*").
+		Where("NOT EXISTS (SELECT 1 FROM portfolio.profile p WHERE p.avatar_file_id = f.id OR p.resume_file_id = f.id)").
+		Where("NOT EXISTS (SELECT 1 FROM portfolio.portfolio_projects pp WHERE pp.image_file_id = f.id)").
+		Where("NOT EXISTS (SELECT 1 FROM portfolio.testimonials t WHERE t.avatar_file_id = f.id)").
+		Where("NOT EXISTS (SELECT 1 FROM miniatures.miniature_themes mt WHERE mt.cover_image_id = f.id)").
+		Where("NOT EXISTS (SELECT 1 FROM miniatures.miniature_files mf WHERE mf.file_id = f.id)").
+		Where("NOT EXISTS (SELECT 1 FROM portfolio.seo_metadata sm WHERE sm.og_image_file_id = f.id)").
+		Where("NOT EXISTS (SELECT 1 FROM blog.articles a WHERE a.cover_file_id = f.id)").
+		Order("f.created_at ASC, f.id ASC").
+		Find(&files).Error
 	if err != nil {
-		return fmt.Errorf("failed to delete image with id %d: %w", id, err)
+		return nil, fmt.Errorf("failed to get orphaned files: %w", err)
 	}
-	return nil
+	return files, nil
 }
@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidMergeSource is returned when a merge source is the target or does
+// not exist
+var ErrInvalidMergeSource = errors.New("invalid merge source")
+
+// errMergePreview rolls back a previewed merge once its result is collected
+var errMergePreview = errors.New("merge preview")
+
+func (r *repository) MergeSkills(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error) {
+	return r.mergeWithRule(ctx, skillDeleteRule, targetID, req)
+}
+
+func (r *repository) MergeSkillTypes(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error) {
+	return r.mergeWithRule(ctx, skillTypeDeleteRule, targetID, req)
+}
+
+func (r *repository) MergeMiniaturePaints(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error) {
+	return r.mergeWithRule(ctx, paintDeleteRule, targetID, req)
+}
+
+// mergeWithRule repoints every reference to the sources at the target and
+// deletes the sources in one transaction. A preview runs the same statements
+// and rolls them back, so the counts are exactly what a merge would change.
+func (r *repository) mergeWithRule(ctx context.Context, rule deleteRule, targetID int64, req models.MergeRequest) (*models.MergeResult, error) {
+	result := &models.MergeResult{
+		TargetID:   targetID,
+		SourceIDs:  req.SourceIDs,
+		Preview:    req.Preview,
+		References: make([]models.MergeReference, len(rule.reassign)),
+		Dependents: []models.Dependent{},
+	}
+	for i, a := range rule.reassign {
+		result.References[i].Table = a.table
+	}
+
+	err := r.transaction(ctx, func(tx *repository) error {
+		if err := tx.checkMergeRecords(ctx, rule, targetID, req.SourceIDs); err != nil {
+			return err
+		}
+
+		db := tx.db.WithContext(ctx)
+		seen := make(map[models.Dependent]bool)
+		for _, sourceID := range req.SourceIDs {
+			params := map[string]interface{}{"id": sourceID, "target": targetID}
+
+			var deps []models.Dependent
+			if err := db.Raw(rule.dependentsSQL, params).Scan(&deps).Error; err != nil {
+				return fmt.Errorf("failed to list dependents of %s %d: %w", rule.entity, sourceID, err)
+			}
+			for _, dep := range deps {
+				if dep.Entity == "" {
+					dep.Entity = rule.dependentsEntity
+				}
+				if !seen[dep] {
+					seen[dep] = true
+					result.Dependents = append(result.Dependents, dep)
+				}
+			}
+
+			for i, a := range rule.reassign {
+				deduped, repointed, err := a.exec(db, params)
+				if err != nil {
+					return fmt.Errorf("failed to merge %s %d into %d: %w", rule.entity, sourceID, targetID, err)
+				}
+				result.References[i].Deduplicated += deduped
+				result.References[i].Repointed += repointed
+			}
+
+			if err := checkRowsAffected(db.Delete(rule.model, sourceID)); err != nil {
+				return asDependentsError(rule.entity, sourceID, err)
+			}
+			if err := tx.writeOutbox(ctx, events.New(rule.entity, events.ActionDeleted, sourceID, "")); err != nil {
+				return err
+			}
+		}
+
+		if req.Preview {
+			return errMergePreview
+		}
+		return tx.writeOutbox(ctx, events.New(rule.entity, events.ActionUpdated, targetID, ""))
+	})
+	if err != nil && !errors.Is(err, errMergePreview) {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkMergeRecords verifies the target exists and every source is another
+// existing record of the same table
+func (r *repository) checkMergeRecords(ctx context.Context, rule deleteRule, targetID int64, sourceIDs []int64) error {
+	ids := append([]int64{targetID}, sourceIDs...)
+	var found []int64
+	if err := r.db.WithContext(ctx).Model(rule.model).Where("id IN ?", ids).Pluck("id", &found).Error; err != nil {
+		return fmt.Errorf("failed to check merge records: %w", err)
+	}
+	exists := make(map[int64]bool, len(found))
+	for _, id := range found {
+		exists[id] = true
+	}
+
+	if !exists[targetID] {
+		return fmt.Errorf("failed to merge into %s %d: %w", rule.entity, targetID, gorm.ErrRecordNotFound)
+	}
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return fmt.Errorf("%w: %d is the merge target", ErrInvalidMergeSource, id)
+		}
+		if !exists[id] {
+			return fmt.Errorf("%w: %s %d does not exist", ErrInvalidMergeSource, rule.entity, id)
+		}
+	}
+	return nil
+}
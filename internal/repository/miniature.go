@@ -4,12 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 	"github.com/GunarsK-portfolio/portfolio-common/utils"
 	"gorm.io/gorm"
 )
 
-func (r *repository) GetAllMiniatureProjects(ctx context.Context) ([]models.MiniatureProject, error) {
+func (r *repository) GetAllMiniatureProjects(ctx context.Context, filter models.ListFilter) ([]models.MiniatureProject, error) {
 	var projects []models.MiniatureProject
 	// Preload Theme, MiniatureFiles, Techniques, and Paints
 	err := r.db.WithContext(ctx).
@@ -20,6 +21,7 @@ func (r *repository) GetAllMiniatureProjects(ctx context.Context) ([]models.Mini
 		Preload("MiniatureFiles.File").
 		Preload("Techniques.Technique").
 		Preload("Paints.Paint").
+		Scopes(taggedWith(models.EntityMiniatureProject, filter.Tag)).
 		Order("display_order ASC, id ASC").
 		Find(&projects).Error
 	if err != nil {
@@ -57,69 +59,85 @@ func (r *repository) GetMiniatureProjectByID(ctx context.Context, id int64) (*mo
 }
 
 func (r *repository) CreateMiniatureProject(ctx context.Context, project *models.MiniatureProject) error {
-	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(project).Error
-	if err != nil {
-		return fmt.Errorf("failed to create miniature project: %w", err)
-	}
-	return nil
+	return r.withOutbox(ctx, models.EntityMiniatureProject, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(project).Error; err != nil {
+			return 0, fmt.Errorf("failed to create miniature project: %w", err)
+		}
+		return project.ID, tx.ensureSlug(ctx, models.EntityMiniatureProject, project.ID)
+	})
 }
 
 func (r *repository) UpdateMiniatureProject(ctx context.Context, project *models.MiniatureProject) error {
-	return r.safeUpdate(ctx, project, project.ID)
+	return r.withOutbox(ctx, models.EntityMiniatureProject, events.ActionUpdated, func(tx *repository) (int64, error) {
+		if err := tx.safeUpdate(ctx, project, project.ID); err != nil {
+			return project.ID, err
+		}
+		return project.ID, tx.ensureSlug(ctx, models.EntityMiniatureProject, project.ID)
+	})
 }
 
 // DeleteMiniatureProject deletes a miniature project and automatically cascades to:
 // - miniatures.miniature_files (links to images)
 // - miniatures.miniature_techniques (links to techniques)
 // - miniatures.miniature_paints (links to paints)
-// Note: Actual files in storage.files are NOT deleted (cleanup job handles orphaned files)
+// Its portfolio.taggings, portfolio.slugs, portfolio.seo_metadata and
+// portfolio.translations rows are removed explicitly (no foreign key).
+// Note: Actual files in storage.files are NOT deleted (purged later via POST /files/orphans/purge)
 func (r *repository) DeleteMiniatureProject(ctx context.Context, id int64) error {
-	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.MiniatureProject{}, id))
+	return r.withOutbox(ctx, models.EntityMiniatureProject, events.ActionDeleted, func(tx *repository) (int64, error) {
+		if err := tx.detach(ctx, models.EntityMiniatureProject, id); err != nil {
+			return id, err
+		}
+		return id, checkRowsAffected(tx.db.WithContext(ctx).Delete(&models.MiniatureProject{}, id))
+	})
 }
 
 // AddImageToProject links an uploaded file to a miniature project
 // Display order is auto-assigned based on the current maximum order + 1
 func (r *repository) AddImageToProject(ctx context.Context, miniatureFile *models.MiniatureFile) error {
-	// First verify the project exists
-	var count int64
-	if err := r.db.WithContext(ctx).Model(&models.MiniatureProject{}).
-		Where("id = ?", miniatureFile.MiniatureProjectID).Count(&count).Error; err != nil {
-		return fmt.Errorf("failed to verify project: %w", err)
-	}
-	if count == 0 {
-		return gorm.ErrRecordNotFound
-	}
-
-	// Auto-assign display order (get max + 1)
-	var maxOrder int
-	r.db.WithContext(ctx).Model(&models.MiniatureFile{}).
-		Where("miniature_project_id = ?", miniatureFile.MiniatureProjectID).
-		Select("COALESCE(MAX(display_order), -1)").
-		Scan(&maxOrder)
-	miniatureFile.DisplayOrder = maxOrder + 1
+	// Adding an image updates the parent project
+	return r.withOutbox(ctx, models.EntityMiniatureProject, events.ActionUpdated, func(tx *repository) (int64, error) {
+		// First verify the project exists
+		var count int64
+		if err := tx.db.WithContext(ctx).Model(&models.MiniatureProject{}).
+			Where("id = ?", miniatureFile.MiniatureProjectID).Count(&count).Error; err != nil {
+			return 0, fmt.Errorf("failed to verify project: %w", err)
+		}
+		if count == 0 {
+			return 0, gorm.ErrRecordNotFound
+		}
 
-	// Create the miniature_files record
-	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt").Create(miniatureFile).Error
-	if err != nil {
-		return fmt.Errorf("failed to add image to project: %w", err)
-	}
+		// Auto-assign display order (get max + 1)
+		var maxOrder int
+		tx.db.WithContext(ctx).Model(&models.MiniatureFile{}).
+			Where("miniature_project_id = ?", miniatureFile.MiniatureProjectID).
+			Select("COALESCE(MAX(display_order), -1)").
+			Scan(&maxOrder)
+		miniatureFile.DisplayOrder = maxOrder + 1
+
+		// Create the miniature_files record
+		err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt").Create(miniatureFile).Error
+		if err != nil {
+			return 0, fmt.Errorf("failed to add image to project: %w", err)
+		}
 
-	// Reload with file data
-	err = r.db.WithContext(ctx).Preload("File").First(miniatureFile, miniatureFile.ID).Error
-	if err != nil {
-		return fmt.Errorf("failed to reload image data: %w", err)
-	}
+		// Reload with file data
+		err = tx.db.WithContext(ctx).Preload("File").First(miniatureFile, miniatureFile.ID).Error
+		if err != nil {
+			return 0, fmt.Errorf("failed to reload image data: %w", err)
+		}
 
-	return nil
+		return miniatureFile.MiniatureProjectID, nil
+	})
 }
 
 // SetProjectTechniques replaces all techniques for a project
 func (r *repository) SetProjectTechniques(ctx context.Context, projectID int64, techniqueIDs []int64) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return r.withOutbox(ctx, models.EntityMiniatureProject, events.ActionUpdated, func(tx *repository) (int64, error) {
 		// Delete existing techniques
-		if err := tx.Where("miniature_project_id = ?", projectID).
+		if err := tx.db.Where("miniature_project_id = ?", projectID).
 			Delete(&models.MiniatureProjectTechnique{}).Error; err != nil {
-			return fmt.Errorf("failed to clear techniques: %w", err)
+			return 0, fmt.Errorf("failed to clear techniques: %w", err)
 		}
 
 		// Insert new techniques
@@ -128,21 +146,21 @@ func (r *repository) SetProjectTechniques(ctx context.Context, projectID int64,
 				MiniatureProjectID: projectID,
 				TechniqueID:        techniqueID,
 			}
-			if err := tx.Omit("ID", "CreatedAt").Create(&link).Error; err != nil {
-				return fmt.Errorf("failed to add technique %d: %w", techniqueID, err)
+			if err := tx.db.Omit("ID", "CreatedAt").Create(&link).Error; err != nil {
+				return 0, fmt.Errorf("failed to add technique %d: %w", techniqueID, err)
 			}
 		}
-		return nil
+		return projectID, nil
 	})
 }
 
 // SetProjectPaints replaces all paints for a project
 func (r *repository) SetProjectPaints(ctx context.Context, projectID int64, paintIDs []int64) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return r.withOutbox(ctx, models.EntityMiniatureProject, events.ActionUpdated, func(tx *repository) (int64, error) {
 		// Delete existing paints
-		if err := tx.Where("miniature_project_id = ?", projectID).
+		if err := tx.db.Where("miniature_project_id = ?", projectID).
 			Delete(&models.MiniatureProjectPaint{}).Error; err != nil {
-			return fmt.Errorf("failed to clear paints: %w", err)
+			return 0, fmt.Errorf("failed to clear paints: %w", err)
 		}
 
 		// Insert new paints
@@ -151,11 +169,11 @@ func (r *repository) SetProjectPaints(ctx context.Context, projectID int64, pain
 				MiniatureProjectID: projectID,
 				PaintID:            paintID,
 			}
-			if err := tx.Omit("ID", "CreatedAt").Create(&link).Error; err != nil {
-				return fmt.Errorf("failed to add paint %d: %w", paintID, err)
+			if err := tx.db.Omit("ID", "CreatedAt").Create(&link).Error; err != nil {
+				return 0, fmt.Errorf("failed to add paint %d: %w", paintID, err)
 			}
 		}
-		return nil
+		return projectID, nil
 	})
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 )
 
@@ -26,17 +27,20 @@ func (r *repository) GetMiniaturePaintByID(ctx context.Context, id int64) (*mode
 }
 
 func (r *repository) CreateMiniaturePaint(ctx context.Context, paint *models.MiniaturePaint) error {
-	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(paint).Error
-	if err != nil {
-		return fmt.Errorf("failed to create miniature paint: %w", err)
-	}
-	return nil
+	return r.withOutbox(ctx, models.EntityMiniaturePaint, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(paint).Error; err != nil {
+			return 0, fmt.Errorf("failed to create miniature paint: %w", err)
+		}
+		return paint.ID, nil
+	})
 }
 
 func (r *repository) UpdateMiniaturePaint(ctx context.Context, paint *models.MiniaturePaint) error {
-	return r.safeUpdate(ctx, paint, paint.ID)
+	return r.withOutbox(ctx, models.EntityMiniaturePaint, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return paint.ID, tx.safeUpdate(ctx, paint, paint.ID)
+	})
 }
 
-func (r *repository) DeleteMiniaturePaint(ctx context.Context, id int64) error {
-	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.MiniaturePaint{}, id))
+func (r *repository) DeleteMiniaturePaint(ctx context.Context, id int64, opts models.DeleteOptions) error {
+	return r.deleteWithRule(ctx, paintDeleteRule, id, opts)
 }
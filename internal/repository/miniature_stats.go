@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// completedInRange restricts a query over miniature projects (aliased "p")
+// to those completed within the filter's date range
+func completedInRange(filter models.MiniatureStatsFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter.From != nil {
+			db = db.Where("p.completed_date >= ?", *filter.From)
+		}
+		if filter.To != nil {
+			db = db.Where("p.completed_date <= ?", *filter.To)
+		}
+		return db
+	}
+}
+
+// GetMiniatureStats aggregates painting output across miniature projects.
+// Every breakdown honors the filter's completion date range; top paints and
+// techniques are capped at filter.Limit entries.
+func (r *repository) GetMiniatureStats(ctx context.Context, filter models.MiniatureStatsFilter) (*models.MiniatureStats, error) {
+	projects := func() *gorm.DB {
+		return r.db.WithContext(ctx).
+			Table("miniatures.miniature_projects AS p").
+			Scopes(completedInRange(filter))
+	}
+
+	stats := &models.MiniatureStats{
+		From:             filter.From,
+		To:               filter.To,
+		CompletedByMonth: []models.PeriodCount{},
+		CompletedByYear:  []models.PeriodCount{},
+		TopPaints:        []models.NamedCount{},
+		TopTechniques:    []models.NamedCount{},
+		ByTheme:          []models.NamedCount{},
+		ByScale:          []models.ValueCount{},
+		ByManufacturer:   []models.ValueCount{},
+		ByDifficulty:     []models.ValueCount{},
+	}
+
+	// Totals
+	err := projects().
+		Select(`COUNT(*) AS total_projects,
+			COUNT(p.completed_date) AS completed_projects,
+			COALESCE(SUM(p.time_spent), 0) AS total_time_spent,
+			COALESCE(AVG(p.time_spent), 0) AS average_time_spent`).
+		Scan(stats).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get miniature totals: %w", err)
+	}
+
+	// Completion timeline
+	for _, period := range []struct {
+		format string
+		dest   *[]models.PeriodCount
+	}{
+		{"YYYY-MM", &stats.CompletedByMonth},
+		{"YYYY", &stats.CompletedByYear},
+	} {
+		err = projects().
+			Select("TO_CHAR(p.completed_date, ?) AS period, COUNT(*) AS count", period.format).
+			Where("p.completed_date IS NOT NULL").
+			Group("period").
+			Order("period ASC").
+			Scan(period.dest).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to get miniature completion timeline: %w", err)
+		}
+	}
+
+	// Most used paints and techniques (junction tables)
+	err = projects().
+		Joins("JOIN miniatures.miniature_paints mp ON mp.miniature_project_id = p.id").
+		Joins("JOIN miniatures.cl_paints cp ON cp.id = mp.paint_id").
+		Select("cp.id AS id, cp.name AS name, cp.manufacturer AS detail, COUNT(DISTINCT p.id) AS count").
+		Group("cp.id, cp.name, cp.manufacturer").
+		Order("count DESC, cp.name ASC").
+		Limit(filter.Limit).
+		Scan(&stats.TopPaints).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get most used paints: %w", err)
+	}
+
+	err = projects().
+		Joins("JOIN miniatures.miniature_techniques mt ON mt.miniature_project_id = p.id").
+		Joins("JOIN miniatures.cl_techniques ct ON ct.id = mt.technique_id").
+		Select("ct.id AS id, ct.name AS name, COUNT(DISTINCT p.id) AS count").
+		Group("ct.id, ct.name").
+		Order("count DESC, ct.name ASC").
+		Limit(filter.Limit).
+		Scan(&stats.TopTechniques).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get most used techniques: %w", err)
+	}
+
+	// Distribution by theme (projects without a theme form their own bucket)
+	err = projects().
+		Joins("LEFT JOIN miniatures.miniature_themes t ON t.id = p.theme_id").
+		Select("t.id AS id, COALESCE(t.name, '') AS name, COUNT(*) AS count").
+		Group("t.id, t.name").
+		Order("count DESC, name ASC").
+		Scan(&stats.ByTheme).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get theme distribution: %w", err)
+	}
+
+	// Distribution by free-text attributes
+	for _, attr := range []struct {
+		column string
+		dest   *[]models.ValueCount
+	}{
+		{"p.scale", &stats.ByScale},
+		{"p.manufacturer", &stats.ByManufacturer},
+		{"p.difficulty", &stats.ByDifficulty},
+	} {
+		err = projects().
+			Select(fmt.Sprintf("COALESCE(%s, '') AS value, COUNT(*) AS count", attr.column)).
+			Group("value").
+			Order("count DESC, value ASC").
+			Scan(attr.dest).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s distribution: %w", attr.column, err)
+		}
+	}
+
+	return stats, nil
+}
@@ -4,14 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 	"github.com/GunarsK-portfolio/portfolio-common/utils"
 )
 
-func (r *repository) GetAllMiniatureThemes(ctx context.Context) ([]models.MiniatureTheme, error) {
+func (r *repository) GetAllMiniatureThemes(ctx context.Context, filter models.ListFilter) ([]models.MiniatureTheme, error) {
 	var themes []models.MiniatureTheme
 	err := r.db.WithContext(ctx).
 		Preload("CoverImageFile").
+		Scopes(taggedWith(models.EntityMiniatureTheme, filter.Tag)).
 		Order("display_order ASC, name ASC").
 		Find(&themes).Error
 	if err != nil {
@@ -43,17 +45,23 @@ func (r *repository) GetMiniatureThemeByID(ctx context.Context, id int64) (*mode
 }
 
 func (r *repository) CreateMiniatureTheme(ctx context.Context, theme *models.MiniatureTheme) error {
-	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(theme).Error
-	if err != nil {
-		return fmt.Errorf("failed to create miniature theme: %w", err)
-	}
-	return nil
+	return r.withOutbox(ctx, models.EntityMiniatureTheme, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(theme).Error; err != nil {
+			return 0, fmt.Errorf("failed to create miniature theme: %w", err)
+		}
+		return theme.ID, tx.ensureSlug(ctx, models.EntityMiniatureTheme, theme.ID)
+	})
 }
 
 func (r *repository) UpdateMiniatureTheme(ctx context.Context, theme *models.MiniatureTheme) error {
-	return r.safeUpdate(ctx, theme, theme.ID)
+	return r.withOutbox(ctx, models.EntityMiniatureTheme, events.ActionUpdated, func(tx *repository) (int64, error) {
+		if err := tx.safeUpdate(ctx, theme, theme.ID); err != nil {
+			return theme.ID, err
+		}
+		return theme.ID, tx.ensureSlug(ctx, models.EntityMiniatureTheme, theme.ID)
+	})
 }
 
-func (r *repository) DeleteMiniatureTheme(ctx context.Context, id int64) error {
-	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.MiniatureTheme{}, id))
+func (r *repository) DeleteMiniatureTheme(ctx context.Context, id int64, opts models.DeleteOptions) error {
+	return r.deleteWithRule(ctx, themeDeleteRule, id, opts)
 }
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	commonrepo "github.com/GunarsK-portfolio/portfolio-common/repository"
+)
+
+// withOutbox runs fn in a transaction and writes the domain event for the change
+// to the outbox before committing, so the event exists if and only if the change
+// does. fn receives a repository bound to the transaction and returns the ID of
+// the changed entity. A foreign key violation while deleting is returned as a
+// *DependentsError.
+func (r *repository) withOutbox(ctx context.Context, entity, action string, fn func(tx *repository) (int64, error)) error {
+	return r.transaction(ctx, func(tx *repository) error {
+		id, err := fn(tx)
+		if err != nil {
+			if action == events.ActionDeleted {
+				return asDependentsError(entity, id, err)
+			}
+			return err
+		}
+		return tx.writeOutbox(ctx, events.New(entity, action, id, ""))
+	})
+}
+
+// transaction runs fn with a repository bound to a new transaction
+func (r *repository) transaction(ctx context.Context, fn func(tx *repository) error) error {
+	return r.db.WithContext(ctx).Transaction(func(db *gorm.DB) error {
+		return fn(&repository{
+			db:          db,
+			filesAPIURL: r.filesAPIURL,
+			SafeUpdater: commonrepo.NewSafeUpdater(db),
+		})
+	})
+}
+
+// writeOutbox inserts a pending outbox row for the event and the webhook
+// deliveries it triggers
+func (r *repository) writeOutbox(ctx context.Context, event events.Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode outbox event: %w", err)
+	}
+	row := models.OutboxEvent{
+		EventID:   event.ID,
+		EventType: event.Type,
+		Entity:    event.Entity,
+		EntityID:  event.EntityID,
+		Payload:   payload,
+		CreatedAt: event.OccurredAt,
+	}
+	if err := r.db.WithContext(ctx).Omit("ID").Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to write outbox event %s: %w", event.Type, err)
+	}
+	return r.enqueueWebhookDeliveries(ctx, event, payload)
+}
+
+// GetPendingOutboxEvents returns unpublished events, oldest first
+func (r *repository) GetPendingOutboxEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	var rows []models.OutboxEvent
+	err := r.db.WithContext(ctx).
+		Where("published_at IS NULL").
+		Order("id ASC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending outbox events: %w", err)
+	}
+	return rows, nil
+}
+
+// MarkOutboxEventPublished records that the broker confirmed the event
+func (r *repository) MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	return checkRowsAffected(r.db.WithContext(ctx).Model(&models.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"published_at": publishedAt,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   nil,
+		}))
+}
+
+// RecordOutboxEventFailure counts a failed publish attempt and keeps the error
+func (r *repository) RecordOutboxEventFailure(ctx context.Context, id int64, lastError string) error {
+	return checkRowsAffected(r.db.WithContext(ctx).Model(&models.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": lastError,
+		}))
+}
+
+// DeletePublishedOutboxEvents removes events published before the cutoff
+func (r *repository) DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("published_at IS NOT NULL AND published_at < ?", before).
+		Delete(&models.OutboxEvent{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete published outbox events: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
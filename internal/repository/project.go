@@ -4,13 +4,16 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 	"github.com/GunarsK-portfolio/portfolio-common/utils"
 )
 
-func (r *repository) GetAllPortfolioProjects(ctx context.Context) ([]models.PortfolioProject, error) {
+func (r *repository) GetAllPortfolioProjects(ctx context.Context, filter models.ListFilter) ([]models.PortfolioProject, error) {
 	var projects []models.PortfolioProject
-	err := r.db.WithContext(ctx).Preload("Technologies").Preload("ImageFile").Order("display_order ASC, created_at DESC").Find(&projects).Error
+	err := r.db.WithContext(ctx).Preload("Technologies").Preload("ImageFile").
+		Scopes(taggedWith(models.EntityPortfolioProject, filter.Tag)).
+		Order("display_order ASC, created_at DESC").Find(&projects).Error
 	if err != nil {
 		return nil, fmt.Errorf("failed to get all portfolio projects: %w", err)
 	}
@@ -37,34 +40,44 @@ func (r *repository) GetPortfolioProjectByID(ctx context.Context, id int64) (*mo
 }
 
 func (r *repository) CreatePortfolioProject(ctx context.Context, project *models.PortfolioProject) error {
-	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(project).Error
-	if err != nil {
-		return fmt.Errorf("failed to create portfolio project: %w", err)
-	}
-	return nil
+	return r.withOutbox(ctx, models.EntityPortfolioProject, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(project).Error; err != nil {
+			return 0, fmt.Errorf("failed to create portfolio project: %w", err)
+		}
+		return project.ID, tx.ensureSlug(ctx, models.EntityPortfolioProject, project.ID)
+	})
 }
 
 func (r *repository) UpdatePortfolioProject(ctx context.Context, project *models.PortfolioProject) error {
-	// Store technologies to update separately (many-to-many needs Association.Replace)
-	technologies := project.Technologies
-	project.Technologies = nil
+	return r.withOutbox(ctx, models.EntityPortfolioProject, events.ActionUpdated, func(tx *repository) (int64, error) {
+		// Store technologies to update separately (many-to-many needs Association.Replace)
+		technologies := project.Technologies
+		project.Technologies = nil
 
-	// Update the project fields
-	if err := r.safeUpdate(ctx, project, project.ID); err != nil {
-		return fmt.Errorf("failed to update portfolio project: %w", err)
-	}
+		// Update the project fields
+		if err := tx.safeUpdate(ctx, project, project.ID); err != nil {
+			return 0, fmt.Errorf("failed to update portfolio project: %w", err)
+		}
 
-	// Replace technologies association (handles junction table correctly)
-	if err := r.db.WithContext(ctx).Model(project).Association("Technologies").Replace(technologies); err != nil {
-		return fmt.Errorf("failed to update portfolio project technologies: %w", err)
-	}
+		// Replace technologies association (handles junction table correctly)
+		if err := tx.db.WithContext(ctx).Model(project).Association("Technologies").Replace(technologies); err != nil {
+			return 0, fmt.Errorf("failed to update portfolio project technologies: %w", err)
+		}
 
-	return nil
+		return project.ID, tx.ensureSlug(ctx, models.EntityPortfolioProject, project.ID)
+	})
 }
 
 // DeletePortfolioProject deletes a portfolio project and automatically cascades to:
 // - portfolio.project_technologies (links to skills/technologies)
-// Note: Image file in storage.files is NOT deleted (cleanup job handles orphaned files)
+// Its portfolio.taggings, portfolio.slugs, portfolio.seo_metadata and
+// portfolio.translations rows are removed explicitly (no foreign key).
+// Note: Image file in storage.files is NOT deleted (purged later via POST /files/orphans/purge)
 func (r *repository) DeletePortfolioProject(ctx context.Context, id int64) error {
-	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.PortfolioProject{}, id))
+	return r.withOutbox(ctx, models.EntityPortfolioProject, events.ActionDeleted, func(tx *repository) (int64, error) {
+		if err := tx.detach(ctx, models.EntityPortfolioProject, id); err != nil {
+			return id, err
+		}
+		return id, checkRowsAffected(tx.db.WithContext(ctx).Delete(&models.PortfolioProject{}, id))
+	})
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 	commonrepo "github.com/GunarsK-portfolio/portfolio-common/repository"
@@ -30,20 +31,37 @@ type Repository interface {
 	CreateCertification(ctx context.Context, cert *models.Certification) error
 	UpdateCertification(ctx context.Context, cert *models.Certification) error
 	DeleteCertification(ctx context.Context, id int64) error
+	GetCertificationReminders(ctx context.Context) ([]models.CertificationReminder, error)
+	CreateCertificationReminder(ctx context.Context, reminder *models.CertificationReminder) error
+
+	// Education
+	GetAllEducation(ctx context.Context) ([]models.Education, error)
+	GetEducationByID(ctx context.Context, id int64) (*models.Education, error)
+	CreateEducation(ctx context.Context, education *models.Education) error
+	UpdateEducation(ctx context.Context, education *models.Education) error
+	DeleteEducation(ctx context.Context, id int64) error
+
+	// Testimonials
+	GetAllTestimonials(ctx context.Context, filter models.TestimonialFilter) ([]models.Testimonial, error)
+	GetTestimonialByID(ctx context.Context, id int64) (*models.Testimonial, error)
+	CreateTestimonial(ctx context.Context, testimonial *models.Testimonial) error
+	UpdateTestimonial(ctx context.Context, testimonial *models.Testimonial) error
+	DeleteTestimonial(ctx context.Context, id int64) error
 
 	// Miniature Themes
-	GetAllMiniatureThemes(ctx context.Context) ([]models.MiniatureTheme, error)
+	GetAllMiniatureThemes(ctx context.Context, filter models.ListFilter) ([]models.MiniatureTheme, error)
 	GetMiniatureThemeByID(ctx context.Context, id int64) (*models.MiniatureTheme, error)
 	CreateMiniatureTheme(ctx context.Context, theme *models.MiniatureTheme) error
 	UpdateMiniatureTheme(ctx context.Context, theme *models.MiniatureTheme) error
-	DeleteMiniatureTheme(ctx context.Context, id int64) error
+	DeleteMiniatureTheme(ctx context.Context, id int64, opts models.DeleteOptions) error
 
 	// Miniature Projects
-	GetAllMiniatureProjects(ctx context.Context) ([]models.MiniatureProject, error)
+	GetAllMiniatureProjects(ctx context.Context, filter models.ListFilter) ([]models.MiniatureProject, error)
 	GetMiniatureProjectByID(ctx context.Context, id int64) (*models.MiniatureProject, error)
 	CreateMiniatureProject(ctx context.Context, project *models.MiniatureProject) error
 	UpdateMiniatureProject(ctx context.Context, project *models.MiniatureProject) error
 	DeleteMiniatureProject(ctx context.Context, id int64) error
+	CloneMiniatureProject(ctx context.Context, id int64, opts models.CloneOptions) (*models.MiniatureProject, error)
 	AddImageToProject(ctx context.Context, miniatureFile *models.MiniatureFile) error
 	SetProjectTechniques(ctx context.Context, projectID int64, techniqueIDs []int64) error
 	SetProjectPaints(ctx context.Context, projectID int64, paintIDs []int64) error
@@ -51,36 +69,107 @@ type Repository interface {
 	// Miniature Techniques
 	GetAllTechniques(ctx context.Context) ([]models.MiniatureTechnique, error)
 
+	// Miniature Stats
+	GetMiniatureStats(ctx context.Context, filter models.MiniatureStatsFilter) (*models.MiniatureStats, error)
+
 	// Miniature Paints
 	GetAllMiniaturePaints(ctx context.Context) ([]models.MiniaturePaint, error)
 	GetMiniaturePaintByID(ctx context.Context, id int64) (*models.MiniaturePaint, error)
 	CreateMiniaturePaint(ctx context.Context, paint *models.MiniaturePaint) error
 	UpdateMiniaturePaint(ctx context.Context, paint *models.MiniaturePaint) error
-	DeleteMiniaturePaint(ctx context.Context, id int64) error
+	DeleteMiniaturePaint(ctx context.Context, id int64, opts models.DeleteOptions) error
+	MergeMiniaturePaints(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error)
+
+	// Articles
+	GetAllArticles(ctx context.Context, filter models.ArticleFilter) ([]models.Article, error)
+	GetArticleByID(ctx context.Context, id int64) (*models.Article, error)
+	CreateArticle(ctx context.Context, article *models.Article, skillIDs []int64) error
+	UpdateArticle(ctx context.Context, article *models.Article, skillIDs []int64) error
+	DeleteArticle(ctx context.Context, id int64) error
+	SetArticleSkills(ctx context.Context, articleID int64, skillIDs []int64) error
 
 	// Skills
 	GetAllSkills(ctx context.Context) ([]models.Skill, error)
 	GetSkillByID(ctx context.Context, id int64) (*models.Skill, error)
 	CreateSkill(ctx context.Context, skill *models.Skill) error
 	UpdateSkill(ctx context.Context, skill *models.Skill) error
-	DeleteSkill(ctx context.Context, id int64) error
+	DeleteSkill(ctx context.Context, id int64, opts models.DeleteOptions) error
+	MergeSkills(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error)
 
 	// Skill Types
 	GetAllSkillTypes(ctx context.Context) ([]models.SkillType, error)
 	GetSkillTypeByID(ctx context.Context, id int64) (*models.SkillType, error)
 	CreateSkillType(ctx context.Context, skillType *models.SkillType) error
 	UpdateSkillType(ctx context.Context, skillType *models.SkillType) error
-	DeleteSkillType(ctx context.Context, id int64) error
+	DeleteSkillType(ctx context.Context, id int64, opts models.DeleteOptions) error
+	MergeSkillTypes(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error)
 
 	// Portfolio Projects
-	GetAllPortfolioProjects(ctx context.Context) ([]models.PortfolioProject, error)
+	GetAllPortfolioProjects(ctx context.Context, filter models.ListFilter) ([]models.PortfolioProject, error)
 	GetPortfolioProjectByID(ctx context.Context, id int64) (*models.PortfolioProject, error)
 	CreatePortfolioProject(ctx context.Context, project *models.PortfolioProject) error
 	UpdatePortfolioProject(ctx context.Context, project *models.PortfolioProject) error
 	DeletePortfolioProject(ctx context.Context, id int64) error
+	ClonePortfolioProject(ctx context.Context, id int64, opts models.CloneOptions) (*models.PortfolioProject, error)
+
+	// Tags
+	GetAllTags(ctx context.Context) ([]models.Tag, error)
+	GetTagByID(ctx context.Context, id int64) (*models.Tag, error)
+	CreateTag(ctx context.Context, tag *models.Tag) error
+	UpdateTag(ctx context.Context, tag *models.Tag) error
+	DeleteTag(ctx context.Context, id int64) error
+	GetEntityTags(ctx context.Context, entity string, id int64) ([]models.Tag, error)
+	SetEntityTags(ctx context.Context, entity string, id int64, tagIDs []int64) error
+
+	// Slugs
+	GetEntitySlugs(ctx context.Context, entity string, id int64) (*models.Slugs, error)
+	SetEntitySlug(ctx context.Context, entity string, id int64, value string) error
+	ResolveSlug(ctx context.Context, entity, value string) (*models.Slug, error)
+	BackfillSlugs(ctx context.Context) (int, error)
+
+	// Visibility
+	GetEntityVisibility(ctx context.Context, entity string, id int64) (*models.Visibility, error)
+	SetEntityVisibility(ctx context.Context, entity string, id int64, visible bool) error
+
+	// SEO
+	GetSEO(ctx context.Context, entity string, id int64) (*models.SEO, error)
+	GetAllSEO(ctx context.Context, entity string) ([]models.SEO, error)
+	SetSEO(ctx context.Context, meta *models.SEOMetadata) error
+
+	// Translations
+	GetTranslations(ctx context.Context, entity string, id int64) (*models.Translations, error)
+	GetTranslationValues(ctx context.Context, entity, locale string, ids []int64) (map[int64]map[string]string, error)
+	SetTranslations(ctx context.Context, entity string, id int64, locale string, values map[string]string) error
+	GetMissingTranslations(ctx context.Context, entity string, locales []string) ([]models.MissingTranslation, error)
 
 	// Images/Files (MinIO storage references)
 	DeleteImage(ctx context.Context, id int64) error
+	GetStorageFileByID(ctx context.Context, id int64) (*models.StorageFile, error)
+	GetOrphanedFiles(ctx context.Context) ([]models.StorageFile, error)
+
+	// Dashboard
+	GetDashboard(ctx context.Context, expiringWithinDays int) (*models.Dashboard, error)
+
+	// Search
+	Search(ctx context.Context, query models.SearchQuery) ([]models.SearchHit, error)
+
+	// Webhooks
+	GetAllWebhooks(ctx context.Context) ([]models.Webhook, error)
+	GetWebhookByID(ctx context.Context, id int64) (*models.Webhook, error)
+	GetActiveWebhooks(ctx context.Context) ([]models.Webhook, error)
+	CreateWebhook(ctx context.Context, webhook *models.Webhook) error
+	UpdateWebhook(ctx context.Context, webhook *models.Webhook) error
+	DeleteWebhook(ctx context.Context, id int64) error
+	GetWebhookDeliveries(ctx context.Context, webhookID int64, limit int) ([]models.WebhookDelivery, error)
+	GetDueWebhookDeliveries(ctx context.Context, now time.Time, excludeWebhookIDs []int64, limit int) ([]models.WebhookDelivery, error)
+	CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+
+	// Outbox (domain events written by the mutations above, published by the relay)
+	GetPendingOutboxEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error
+	RecordOutboxEventFailure(ctx context.Context, id int64, lastError string) error
+	DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error)
 }
 
 type repository struct {
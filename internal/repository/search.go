@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// Snippet matches are delimited with control characters so the stored text can
+// be HTML-escaped before they are turned into <mark> tags
+const (
+	snippetStart   = "\x02"
+	snippetStop    = "\x03"
+	snippetOptions = `StartSel=` + snippetStart + `, StopSel=` + snippetStop +
+		`, MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter=" … "`
+)
+
+// searchSource describes how one entity is searched. Documents and queries use
+// the english configuration. document must match the expression of the GIN
+// index on the table (see README), or the index is not used; snippet is the
+// text highlighted around the matches.
+type searchSource struct {
+	table    string
+	title    string
+	document string
+	snippet  string
+}
+
+var searchSources = map[string]searchSource{
+	models.EntityPortfolioProject: {
+		table: "portfolio.portfolio_projects",
+		title: "title",
+		document: `setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+			setweight(to_tsvector('english', coalesce(description, '')), 'B') ||
+			setweight(to_tsvector('english', coalesce(long_description, '')), 'C') ||
+			setweight(jsonb_to_tsvector('english', coalesce(features, '[]'::jsonb), '["string"]'), 'C')`,
+		snippet: `concat_ws(' ', description, long_description,
+			(SELECT string_agg(feature, ' ') FROM jsonb_array_elements_text(coalesce(features, '[]'::jsonb)) AS feature))`,
+	},
+	models.EntityWorkExperience: {
+		table: "portfolio.work_experience",
+		title: "company || ' - ' || position",
+		document: `setweight(to_tsvector('english', coalesce(position, '') || ' ' || coalesce(company, '')), 'A') ||
+			setweight(to_tsvector('english', coalesce(description, '')), 'B')`,
+		snippet: "concat_ws(' ', company, description)",
+	},
+	models.EntityCertification: {
+		table: "portfolio.certifications",
+		title: "name",
+		document: `setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+			setweight(to_tsvector('english', coalesce(issuer, '')), 'B')`,
+		snippet: "concat_ws(' ', name, issuer)",
+	},
+	models.EntitySkill: {
+		table:    "portfolio.skills",
+		title:    "skill",
+		document: `setweight(to_tsvector('english', coalesce(skill, '')), 'A')`,
+		snippet:  "skill",
+	},
+	models.EntityMiniatureProject: {
+		table: "miniatures.miniature_projects",
+		title: "title",
+		document: `setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+			setweight(to_tsvector('english', coalesce(description, '')), 'B') ||
+			setweight(to_tsvector('english', coalesce(manufacturer, '') || ' ' || coalesce(scale, '')), 'C')`,
+		snippet: "concat_ws(' ', description, manufacturer, scale)",
+	},
+	models.EntityMiniatureTheme: {
+		table: "miniatures.miniature_themes",
+		title: "name",
+		document: `setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+			setweight(to_tsvector('english', coalesce(description, '')), 'B')`,
+		snippet: "concat_ws(' ', name, description)",
+	},
+	models.EntityArticle: {
+		table: "blog.articles",
+		title: "title",
+		document: `setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+			setweight(to_tsvector('english', coalesce(summary, '')), 'B') ||
+			setweight(to_tsvector('english', coalesce(body, '')), 'C')`,
+		snippet: "concat_ws(' ', summary, body)",
+	},
+}
+
+// Search runs a web-style full-text query (quoted phrases, OR, -exclusion)
+// over the requested entity types and returns the best ranked hits
+func (r *repository) Search(ctx context.Context, query models.SearchQuery) ([]models.SearchHit, error) {
+	hits := []models.SearchHit{}
+	if len(query.Entities) == 0 {
+		return hits, nil
+	}
+
+	selects := make([]string, 0, len(query.Entities))
+	for _, entity := range query.Entities {
+		source, ok := searchSources[entity]
+		if !ok {
+			return nil, fmt.Errorf("entity %s is not searchable", entity)
+		}
+		selects = append(selects, fmt.Sprintf(
+			`SELECT '%s' AS entity, id AS entity_id, %s AS title, ts_rank(%s, query) AS rank,
+				ts_headline('english', %s, query, @options) AS snippet
+			FROM %s, websearch_to_tsquery('english', @text) AS query
+			WHERE %s @@ query`,
+			entity, source.title, source.document, source.snippet, source.table, source.document))
+	}
+	sql := `SELECT * FROM (` + strings.Join(selects, " UNION ALL ") + `) AS hits
+		ORDER BY rank DESC, entity ASC, entity_id ASC LIMIT @limit`
+
+	err := r.db.WithContext(ctx).Raw(sql, map[string]interface{}{
+		"text":    query.Text,
+		"options": snippetOptions,
+		"limit":   query.Limit,
+	}).Scan(&hits).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+
+	for i := range hits {
+		hits[i].Snippet = highlight(hits[i].Snippet)
+	}
+	return hits, nil
+}
+
+// highlight escapes a ts_headline snippet and marks the matches
+func highlight(snippet string) string {
+	escaped := html.EscapeString(snippet)
+	return strings.NewReplacer(snippetStart, "<mark>", snippetStop, "</mark>").Replace(escaped)
+}
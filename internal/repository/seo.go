@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/markdown"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/seo"
+	"github.com/GunarsK-portfolio/portfolio-common/utils"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// seoSources select what SEO defaults are derived from: id, title,
+// description and image_file_id (the main image) of every record
+var seoSources = map[string]string{
+	models.EntityProfile: `SELECT id, concat_ws(' - ', nullif(full_name, ''), nullif(title, '')) AS title,
+		bio AS description, avatar_file_id AS image_file_id FROM portfolio.profile`,
+	models.EntityPortfolioProject: `SELECT id, title, description, image_file_id FROM portfolio.portfolio_projects`,
+	models.EntityMiniatureProject: `SELECT p.id, p.title, p.description, (SELECT mf.file_id FROM miniatures.miniature_files mf
+		WHERE mf.miniature_project_id = p.id ORDER BY mf.display_order, mf.id LIMIT 1) AS image_file_id
+		FROM miniatures.miniature_projects p`,
+	models.EntityMiniatureTheme: `SELECT id, name AS title, description, cover_image_id AS image_file_id FROM miniatures.miniature_themes`,
+	models.EntityArticle:        `SELECT id, title, coalesce(nullif(summary, ''), body) AS description, cover_file_id AS image_file_id FROM blog.articles`,
+}
+
+// seoSourceRow is one record read through seoSources
+type seoSourceRow struct {
+	ID          int64
+	Title       string
+	Description string
+	ImageFileID *int64
+}
+
+// GetSEO returns the resolved SEO metadata of one record
+func (r *repository) GetSEO(ctx context.Context, entity string, id int64) (*models.SEO, error) {
+	items, err := r.loadSEO(ctx, entity, &id)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("failed to get %s with id %d: %w", entity, id, gorm.ErrRecordNotFound)
+	}
+	return &items[0], nil
+}
+
+// GetAllSEO returns the resolved SEO metadata of every record of entity, by ID
+func (r *repository) GetAllSEO(ctx context.Context, entity string) ([]models.SEO, error) {
+	return r.loadSEO(ctx, entity, nil)
+}
+
+// SetSEO stores the SEO overrides of one record, replacing the previous ones.
+// Changing them updates the record.
+func (r *repository) SetSEO(ctx context.Context, meta *models.SEOMetadata) error {
+	return r.withOutbox(ctx, meta.Entity, events.ActionUpdated, func(tx *repository) (int64, error) {
+		source, ok := seoSources[meta.Entity]
+		if !ok {
+			return meta.EntityID, fmt.Errorf("entity %s has no SEO metadata", meta.Entity)
+		}
+		db := tx.db.WithContext(ctx)
+
+		var count int64
+		if err := db.Raw("SELECT count(*) FROM ("+source+") s WHERE s.id = ?", meta.EntityID).Scan(&count).Error; err != nil {
+			return meta.EntityID, fmt.Errorf("failed to verify %s %d: %w", meta.Entity, meta.EntityID, err)
+		}
+		if count == 0 {
+			return meta.EntityID, fmt.Errorf("failed to get %s with id %d: %w", meta.Entity, meta.EntityID, gorm.ErrRecordNotFound)
+		}
+
+		err := db.Omit("ID").Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity"}, {Name: "entity_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"meta_title", "meta_description", "canonical_url", "og_image_file_id", "updated_at"}),
+		}).Create(meta).Error
+		if err != nil {
+			return meta.EntityID, fmt.Errorf("failed to save SEO metadata of %s %d: %w", meta.Entity, meta.EntityID, err)
+		}
+		return meta.EntityID, nil
+	})
+}
+
+// loadSEO resolves the SEO metadata of the records of entity, or only of id
+// when set
+func (r *repository) loadSEO(ctx context.Context, entity string, id *int64) ([]models.SEO, error) {
+	source, ok := seoSources[entity]
+	if !ok {
+		return nil, fmt.Errorf("entity %s has no SEO metadata", entity)
+	}
+	db := r.db.WithContext(ctx)
+
+	query := "SELECT * FROM (" + source + ") s"
+	var args []interface{}
+	if id != nil {
+		query += " WHERE s.id = ?"
+		args = append(args, *id)
+	}
+	var rows []seoSourceRow
+	if err := db.Raw(query+" ORDER BY s.id", args...).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get SEO sources of %s: %w", entity, err)
+	}
+	if len(rows) == 0 {
+		return []models.SEO{}, nil
+	}
+
+	ids := make([]int64, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	var stored []models.SEOMetadata
+	if err := db.Where("entity = ? AND entity_id IN ?", entity, ids).Find(&stored).Error; err != nil {
+		return nil, fmt.Errorf("failed to get SEO metadata of %s: %w", entity, err)
+	}
+	storedByID := make(map[int64]models.SEOMetadata, len(stored))
+	var fileIDs []int64
+	for _, meta := range stored {
+		storedByID[meta.EntityID] = meta
+		if meta.OGImageFileID != nil {
+			fileIDs = append(fileIDs, *meta.OGImageFileID)
+		}
+	}
+	for _, row := range rows {
+		if row.ImageFileID != nil {
+			fileIDs = append(fileIDs, *row.ImageFileID)
+		}
+	}
+
+	files, err := r.storageFiles(ctx, fileIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]models.SEO, len(rows))
+	for i, row := range rows {
+		meta := storedByID[row.ID]
+		var override *models.StorageFile
+		if meta.OGImageFileID != nil {
+			override = files[*meta.OGImageFileID]
+		}
+		src := seo.Source{Title: row.Title, Description: row.Description}
+		if entity == models.EntityMiniatureProject || entity == models.EntityArticle {
+			src.Description = markdown.Text(row.Description)
+		}
+		if row.ImageFileID != nil {
+			src.Image = files[*row.ImageFileID]
+		}
+		items[i] = seo.Resolve(entity, row.ID, src, meta, override)
+	}
+	return items, nil
+}
+
+// storageFiles loads storage files by ID with their URLs
+func (r *repository) storageFiles(ctx context.Context, ids []int64) (map[int64]*models.StorageFile, error) {
+	files := make(map[int64]*models.StorageFile, len(ids))
+	if len(ids) == 0 {
+		return files, nil
+	}
+	var rows []models.StorageFile
+	if err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get storage files: %w", err)
+	}
+	for i := range rows {
+		utils.PopulateFileURL(&rows[i], r.filesAPIURL)
+		files[rows[i].ID] = &rows[i]
+	}
+	return files, nil
+}
+
+// dropSEO removes the SEO metadata of a record being deleted
+func (r *repository) dropSEO(ctx context.Context, entity string, id int64) error {
+	if err := r.db.WithContext(ctx).Where("entity = ? AND entity_id = ?", entity, id).Delete(&models.SEOMetadata{}).Error; err != nil {
+		return fmt.Errorf("failed to remove SEO metadata of %s %d: %w", entity, id, err)
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 )
 
@@ -28,19 +29,22 @@ func (r *repository) GetSkillByID(ctx context.Context, id int64) (*models.Skill,
 }
 
 func (r *repository) CreateSkill(ctx context.Context, skill *models.Skill) error {
-	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(skill).Error
-	if err != nil {
-		return fmt.Errorf("failed to create skill: %w", err)
-	}
-	return nil
+	return r.withOutbox(ctx, models.EntitySkill, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(skill).Error; err != nil {
+			return 0, fmt.Errorf("failed to create skill: %w", err)
+		}
+		return skill.ID, nil
+	})
 }
 
 func (r *repository) UpdateSkill(ctx context.Context, skill *models.Skill) error {
-	return r.safeUpdate(ctx, skill, skill.ID)
+	return r.withOutbox(ctx, models.EntitySkill, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return skill.ID, tx.safeUpdate(ctx, skill, skill.ID)
+	})
 }
 
-func (r *repository) DeleteSkill(ctx context.Context, id int64) error {
-	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.Skill{}, id))
+func (r *repository) DeleteSkill(ctx context.Context, id int64, opts models.DeleteOptions) error {
+	return r.deleteWithRule(ctx, skillDeleteRule, id, opts)
 }
 
 // Skill Types
@@ -64,17 +68,20 @@ func (r *repository) GetSkillTypeByID(ctx context.Context, id int64) (*models.Sk
 }
 
 func (r *repository) CreateSkillType(ctx context.Context, skillType *models.SkillType) error {
-	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(skillType).Error
-	if err != nil {
-		return fmt.Errorf("failed to create skill type: %w", err)
-	}
-	return nil
+	return r.withOutbox(ctx, models.EntitySkillType, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(skillType).Error; err != nil {
+			return 0, fmt.Errorf("failed to create skill type: %w", err)
+		}
+		return skillType.ID, nil
+	})
 }
 
 func (r *repository) UpdateSkillType(ctx context.Context, skillType *models.SkillType) error {
-	return r.safeUpdate(ctx, skillType, skillType.ID)
+	return r.withOutbox(ctx, models.EntitySkillType, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return skillType.ID, tx.safeUpdate(ctx, skillType, skillType.ID)
+	})
 }
 
-func (r *repository) DeleteSkillType(ctx context.Context, id int64) error {
-	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.SkillType{}, id))
+func (r *repository) DeleteSkillType(ctx context.Context, id int64, opts models.DeleteOptions) error {
+	return r.deleteWithRule(ctx, skillTypeDeleteRule, id, opts)
 }
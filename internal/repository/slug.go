@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/slug"
+	"gorm.io/gorm"
+)
+
+// ErrSlugTaken is returned when another record of the same kind uses the
+// slug now or used it before
+var ErrSlugTaken = errors.New("slug already in use")
+
+// slugSource is where the slugs of an entity type come from
+type slugSource struct {
+	model  interface{}
+	column string
+	// fallback is the base slug when the title has no letters or digits
+	fallback string
+}
+
+// sluggableSources maps the entity types that have slugs to their records
+var sluggableSources = map[string]slugSource{
+	models.EntityPortfolioProject: {&models.PortfolioProject{}, "title", "project"},
+	models.EntityMiniatureProject: {&models.MiniatureProject{}, "title", "miniature"},
+	models.EntityMiniatureTheme:   {&models.MiniatureTheme{}, "name", "theme"},
+	models.EntityArticle:          {&models.Article{}, "title", "article"},
+}
+
+// GetEntitySlugs returns the current and previous slugs of one record
+func (r *repository) GetEntitySlugs(ctx context.Context, entity string, id int64) (*models.Slugs, error) {
+	if err := r.checkSluggable(ctx, entity, id); err != nil {
+		return nil, err
+	}
+	var rows []models.Slug
+	err := r.db.WithContext(ctx).
+		Where("entity = ? AND entity_id = ?", entity, id).
+		Order("is_current DESC, created_at DESC, id DESC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slugs of %s %d: %w", entity, id, err)
+	}
+
+	slugs := &models.Slugs{Previous: []models.Slug{}}
+	for _, row := range rows {
+		if row.IsCurrent {
+			slugs.Current = row.Slug
+		} else {
+			slugs.Previous = append(slugs.Previous, row)
+		}
+	}
+	return slugs, nil
+}
+
+// SetEntitySlug makes value the current slug of one record. The slug it
+// replaces stays in the history; a previous slug of the same record can be
+// taken back. Changing the slug updates the record.
+func (r *repository) SetEntitySlug(ctx context.Context, entity string, id int64, value string) error {
+	return r.withOutbox(ctx, entity, events.ActionUpdated, func(tx *repository) (int64, error) {
+		if err := tx.checkSluggable(ctx, entity, id); err != nil {
+			return id, err
+		}
+		if err := tx.lockSlugs(ctx, entity); err != nil {
+			return id, err
+		}
+
+		db := tx.db.WithContext(ctx)
+		var existing []models.Slug
+		if err := db.Where("entity = ? AND slug = ?", entity, value).Find(&existing).Error; err != nil {
+			return id, fmt.Errorf("failed to check slug %s: %w", value, err)
+		}
+		if len(existing) > 0 {
+			if existing[0].EntityID != id {
+				return id, fmt.Errorf("%w: %s is used by %s %d", ErrSlugTaken, value, entity, existing[0].EntityID)
+			}
+			if existing[0].IsCurrent {
+				return id, nil
+			}
+			// Taking back a previous slug: it becomes current as of now
+			if err := db.Delete(&existing[0]).Error; err != nil {
+				return id, fmt.Errorf("failed to restore slug %s: %w", value, err)
+			}
+		}
+
+		if err := db.Model(&models.Slug{}).
+			Where("entity = ? AND entity_id = ? AND is_current", entity, id).
+			Update("is_current", false).Error; err != nil {
+			return id, fmt.Errorf("failed to retire slug of %s %d: %w", entity, id, err)
+		}
+		return id, tx.addSlug(ctx, entity, id, value)
+	})
+}
+
+// ResolveSlug finds the record of entity that uses value as its current or a
+// previous slug and returns the record's current slug
+func (r *repository) ResolveSlug(ctx context.Context, entity, value string) (*models.Slug, error) {
+	var current models.Slug
+	result := r.db.WithContext(ctx).Raw(`SELECT cur.* FROM portfolio.slugs s
+		JOIN portfolio.slugs cur ON cur.entity = s.entity AND cur.entity_id = s.entity_id AND cur.is_current
+		WHERE s.entity = ? AND s.slug = ?`, entity, value).Scan(&current)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to resolve %s slug %s: %w", entity, value, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("failed to get %s with slug %s: %w", entity, value, gorm.ErrRecordNotFound)
+	}
+	return &current, nil
+}
+
+// BackfillSlugs gives every record without a current slug one through
+// ensureSlug, so records created before slugs existed resolve by slug. Each
+// record is slugged in its own transaction; it returns how many were slugged.
+func (r *repository) BackfillSlugs(ctx context.Context) (int, error) {
+	entities := make([]string, 0, len(sluggableSources))
+	for entity := range sluggableSources {
+		entities = append(entities, entity)
+	}
+	sort.Strings(entities)
+
+	backfilled := 0
+	for _, entity := range entities {
+		var ids []int64
+		err := r.db.WithContext(ctx).Model(sluggableSources[entity].model).
+			Where("id NOT IN (SELECT entity_id FROM portfolio.slugs WHERE entity = ? AND is_current)", entity).
+			Order("id").
+			Pluck("id", &ids).Error
+		if err != nil {
+			return backfilled, fmt.Errorf("failed to find %s records without slugs: %w", entity, err)
+		}
+		for _, id := range ids {
+			err := r.transaction(ctx, func(tx *repository) error {
+				return tx.ensureSlug(ctx, entity, id)
+			})
+			if err != nil {
+				return backfilled, err
+			}
+			backfilled++
+		}
+	}
+	return backfilled, nil
+}
+
+// ensureSlug gives a record without a current slug one derived from its title
+// or name, with the lowest free collision suffix. Records created before slugs
+// existed get theirs from BackfillSlugs at startup.
+func (r *repository) ensureSlug(ctx context.Context, entity string, id int64) error {
+	source := sluggableSources[entity]
+	db := r.db.WithContext(ctx)
+
+	var count int64
+	if err := db.Model(&models.Slug{}).Where("entity = ? AND entity_id = ? AND is_current", entity, id).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check slug of %s %d: %w", entity, id, err)
+	}
+	if count > 0 {
+		return nil
+	}
+	if err := r.lockSlugs(ctx, entity); err != nil {
+		return err
+	}
+
+	var titles []string
+	if err := db.Model(source.model).Where("id = ?", id).Pluck(source.column, &titles).Error; err != nil {
+		return fmt.Errorf("failed to get %s of %s %d: %w", source.column, entity, id, err)
+	}
+	base := source.fallback
+	if len(titles) > 0 && slug.Make(titles[0]) != "" {
+		base = slug.Make(titles[0])
+	}
+
+	candidate := base
+	for n := 2; ; n++ {
+		if err := db.Model(&models.Slug{}).Where("entity = ? AND slug = ?", entity, candidate).Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check slug %s: %w", candidate, err)
+		}
+		if count == 0 {
+			break
+		}
+		candidate = slug.WithSuffix(base, n)
+	}
+	return r.addSlug(ctx, entity, id, candidate)
+}
+
+// addSlug inserts value as the current slug of a record
+func (r *repository) addSlug(ctx context.Context, entity string, id int64, value string) error {
+	row := models.Slug{Entity: entity, EntityID: id, Slug: value, IsCurrent: true}
+	if err := r.db.WithContext(ctx).Omit("ID", "CreatedAt").Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to add slug %s to %s %d: %w", value, entity, id, err)
+	}
+	return nil
+}
+
+// lockSlugs serializes slug changes of one entity type until the transaction
+// ends, so two records cannot both pick the same free slug
+func (r *repository) lockSlugs(ctx context.Context, entity string) error {
+	if err := r.db.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "slugs:"+entity).Error; err != nil {
+		return fmt.Errorf("failed to lock %s slugs: %w", entity, err)
+	}
+	return nil
+}
+
+// unslug removes the slugs of a record being deleted, so they can be reused
+func (r *repository) unslug(ctx context.Context, entity string, id int64) error {
+	if _, ok := sluggableSources[entity]; !ok {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Where("entity = ? AND entity_id = ?", entity, id).Delete(&models.Slug{}).Error; err != nil {
+		return fmt.Errorf("failed to remove slugs of %s %d: %w", entity, id, err)
+	}
+	return nil
+}
+
+// checkSluggable verifies entity has slugs and the record exists
+func (r *repository) checkSluggable(ctx context.Context, entity string, id int64) error {
+	source, ok := sluggableSources[entity]
+	if !ok {
+		return fmt.Errorf("entity %s has no slugs", entity)
+	}
+	return r.checkRecord(ctx, entity, source.model, id)
+}
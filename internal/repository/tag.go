@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// uniqueViolation is the Postgres SQLSTATE for a unique constraint violation
+const uniqueViolation = "23505"
+
+// ErrTagExists is returned when another tag already has the name
+var ErrTagExists = errors.New("tag name already exists")
+
+// ErrUnknownTags is returned when tagging with tag IDs that do not exist
+var ErrUnknownTags = errors.New("unknown tags")
+
+// taggableModels maps the entity types that can carry tags to their records
+var taggableModels = map[string]interface{}{
+	models.EntityPortfolioProject: &models.PortfolioProject{},
+	models.EntityMiniatureProject: &models.MiniatureProject{},
+	models.EntityMiniatureTheme:   &models.MiniatureTheme{},
+	models.EntityArticle:          &models.Article{},
+}
+
+// taggedWith keeps records of entity tagged with the named tag. An empty name
+// keeps every record.
+func taggedWith(entity, tag string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if tag == "" {
+			return db
+		}
+		return db.Where(`id IN (SELECT tg.entity_id FROM portfolio.taggings tg
+			JOIN portfolio.tags t ON t.id = tg.tag_id
+			WHERE tg.entity = ? AND lower(t.name) = lower(?))`, entity, tag)
+	}
+}
+
+// withUsage selects tags with the number of records tagged
+func withUsage(db *gorm.DB) *gorm.DB {
+	return db.Model(&models.Tag{}).
+		Select("portfolio.tags.*, COUNT(tg.id) AS usage_count").
+		Joins("LEFT JOIN portfolio.taggings tg ON tg.tag_id = portfolio.tags.id").
+		Group("portfolio.tags.id")
+}
+
+func (r *repository) GetAllTags(ctx context.Context) ([]models.Tag, error) {
+	var tags []models.Tag
+	if err := r.db.WithContext(ctx).Scopes(withUsage).Order("lower(portfolio.tags.name) ASC").Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("failed to get all tags: %w", err)
+	}
+	if err := r.fillTagUsage(ctx, tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+func (r *repository) GetTagByID(ctx context.Context, id int64) (*models.Tag, error) {
+	var tag models.Tag
+	if err := r.db.WithContext(ctx).Scopes(withUsage).Where("portfolio.tags.id = ?", id).First(&tag).Error; err != nil {
+		return nil, fmt.Errorf("failed to get tag with id %d: %w", id, err)
+	}
+	tags := []models.Tag{tag}
+	if err := r.fillTagUsage(ctx, tags); err != nil {
+		return nil, err
+	}
+	return &tags[0], nil
+}
+
+// fillTagUsage splits the usage counts of tags by entity type
+func (r *repository) fillTagUsage(ctx context.Context, tags []models.Tag) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(tags))
+	for i, tag := range tags {
+		ids[i] = tag.ID
+	}
+
+	var rows []struct {
+		TagID  int64
+		Entity string
+		Count  int64
+	}
+	err := r.db.WithContext(ctx).Model(&models.Tagging{}).
+		Select("tag_id, entity, COUNT(*) AS count").
+		Where("tag_id IN ?", ids).
+		Group("tag_id, entity").
+		Scan(&rows).Error
+	if err != nil {
+		return fmt.Errorf("failed to count tag usage: %w", err)
+	}
+
+	index := make(map[int64]int, len(tags))
+	for i := range tags {
+		index[tags[i].ID] = i
+		tags[i].Usage = map[string]int64{}
+	}
+	for _, row := range rows {
+		tags[index[row.TagID]].Usage[row.Entity] = row.Count
+	}
+	return nil
+}
+
+func (r *repository) CreateTag(ctx context.Context, tag *models.Tag) error {
+	return r.withOutbox(ctx, models.EntityTag, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "UsageCount", "CreatedAt", "UpdatedAt").Create(tag).Error; err != nil {
+			return 0, fmt.Errorf("failed to create tag: %w", asTagExists(err))
+		}
+		return tag.ID, nil
+	})
+}
+
+func (r *repository) UpdateTag(ctx context.Context, tag *models.Tag) error {
+	return r.withOutbox(ctx, models.EntityTag, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return tag.ID, asTagExists(tx.safeUpdate(ctx, tag, tag.ID))
+	})
+}
+
+// DeleteTag deletes a tag and removes it from every record
+func (r *repository) DeleteTag(ctx context.Context, id int64) error {
+	return r.withOutbox(ctx, models.EntityTag, events.ActionDeleted, func(tx *repository) (int64, error) {
+		db := tx.db.WithContext(ctx)
+		if err := db.Where("tag_id = ?", id).Delete(&models.Tagging{}).Error; err != nil {
+			return id, fmt.Errorf("failed to remove tag %d from records: %w", id, err)
+		}
+		return id, checkRowsAffected(db.Delete(&models.Tag{}, id))
+	})
+}
+
+// GetEntityTags returns the tags of one record, by name
+func (r *repository) GetEntityTags(ctx context.Context, entity string, id int64) ([]models.Tag, error) {
+	if err := r.checkTaggable(ctx, entity, id); err != nil {
+		return nil, err
+	}
+	tags := []models.Tag{}
+	err := r.db.WithContext(ctx).
+		Select("portfolio.tags.*").
+		Joins("JOIN portfolio.taggings tg ON tg.tag_id = portfolio.tags.id").
+		Where("tg.entity = ? AND tg.entity_id = ?", entity, id).
+		Order("lower(portfolio.tags.name) ASC").
+		Find(&tags).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags of %s %d: %w", entity, id, err)
+	}
+	return tags, nil
+}
+
+// SetEntityTags replaces the tags of one record. Tagging a record updates it,
+// so the event is emitted for the record, not the tags.
+func (r *repository) SetEntityTags(ctx context.Context, entity string, id int64, tagIDs []int64) error {
+	return r.withOutbox(ctx, entity, events.ActionUpdated, func(tx *repository) (int64, error) {
+		if err := tx.checkTaggable(ctx, entity, id); err != nil {
+			return id, err
+		}
+
+		unique := make([]int64, 0, len(tagIDs))
+		seen := make(map[int64]bool, len(tagIDs))
+		for _, tagID := range tagIDs {
+			if !seen[tagID] {
+				seen[tagID] = true
+				unique = append(unique, tagID)
+			}
+		}
+
+		db := tx.db.WithContext(ctx)
+		if len(unique) > 0 {
+			var count int64
+			if err := db.Model(&models.Tag{}).Where("id IN ?", unique).Count(&count).Error; err != nil {
+				return id, fmt.Errorf("failed to check tags: %w", err)
+			}
+			if count != int64(len(unique)) {
+				return id, fmt.Errorf("%w: %d of %d tags do not exist", ErrUnknownTags, int64(len(unique))-count, len(unique))
+			}
+		}
+
+		if err := db.Where("entity = ? AND entity_id = ?", entity, id).Delete(&models.Tagging{}).Error; err != nil {
+			return id, fmt.Errorf("failed to clear tags of %s %d: %w", entity, id, err)
+		}
+		for _, tagID := range unique {
+			tagging := models.Tagging{TagID: tagID, Entity: entity, EntityID: id}
+			if err := db.Omit("ID", "CreatedAt").Create(&tagging).Error; err != nil {
+				return id, fmt.Errorf("failed to add tag %d: %w", tagID, err)
+			}
+		}
+		return id, nil
+	})
+}
+
+// untag removes the taggings of a record being deleted. Taggings are
+// polymorphic, so no foreign key cascades them.
+func (r *repository) untag(ctx context.Context, entity string, id int64) error {
+	if _, ok := taggableModels[entity]; !ok {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Where("entity = ? AND entity_id = ?", entity, id).Delete(&models.Tagging{}).Error; err != nil {
+		return fmt.Errorf("failed to remove tags of %s %d: %w", entity, id, err)
+	}
+	return nil
+}
+
+// checkTaggable verifies entity can carry tags and the record exists
+func (r *repository) checkTaggable(ctx context.Context, entity string, id int64) error {
+	model, ok := taggableModels[entity]
+	if !ok {
+		return fmt.Errorf("entity %s cannot be tagged", entity)
+	}
+	return r.checkRecord(ctx, entity, model, id)
+}
+
+// checkRecord verifies the record id of model exists
+func (r *repository) checkRecord(ctx context.Context, entity string, model interface{}, id int64) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(model).Where("id = ?", id).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to verify %s %d: %w", entity, id, err)
+	}
+	if count == 0 {
+		return fmt.Errorf("failed to get %s with id %d: %w", entity, id, gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// asTagExists turns a unique violation on the tag name into ErrTagExists
+func asTagExists(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return fmt.Errorf("%w: %w", ErrTagExists, err)
+	}
+	return err
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/portfolio-common/utils"
+)
+
+func (r *repository) GetAllTestimonials(ctx context.Context, filter models.TestimonialFilter) ([]models.Testimonial, error) {
+	query := r.db.WithContext(ctx).Preload("AvatarFile")
+	if filter.Approved != nil {
+		query = query.Where("approved = ?", *filter.Approved)
+	}
+	if filter.WorkExperienceID != nil {
+		query = query.Where("work_experience_id = ?", *filter.WorkExperienceID)
+	}
+	if filter.PortfolioProjectID != nil {
+		query = query.Where("portfolio_project_id = ?", *filter.PortfolioProjectID)
+	}
+
+	var testimonials []models.Testimonial
+	if err := query.Order("display_order ASC, created_at DESC").Find(&testimonials).Error; err != nil {
+		return nil, fmt.Errorf("failed to get all testimonials: %w", err)
+	}
+
+	// Populate avatar URLs
+	for i := range testimonials {
+		utils.PopulateFileURL(testimonials[i].AvatarFile, r.filesAPIURL)
+	}
+	return testimonials, nil
+}
+
+func (r *repository) GetTestimonialByID(ctx context.Context, id int64) (*models.Testimonial, error) {
+	var testimonial models.Testimonial
+	err := r.db.WithContext(ctx).Preload("AvatarFile").First(&testimonial, id).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get testimonial with id %d: %w", id, err)
+	}
+
+	// Populate avatar URL
+	utils.PopulateFileURL(testimonial.AvatarFile, r.filesAPIURL)
+	return &testimonial, nil
+}
+
+func (r *repository) CreateTestimonial(ctx context.Context, testimonial *models.Testimonial) error {
+	return r.withOutbox(ctx, models.EntityTestimonial, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt", "AvatarFile").Create(testimonial).Error; err != nil {
+			return 0, fmt.Errorf("failed to create testimonial: %w", err)
+		}
+		return testimonial.ID, nil
+	})
+}
+
+func (r *repository) UpdateTestimonial(ctx context.Context, testimonial *models.Testimonial) error {
+	return r.withOutbox(ctx, models.EntityTestimonial, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return testimonial.ID, tx.safeUpdate(ctx, testimonial, testimonial.ID)
+	})
+}
+
+func (r *repository) DeleteTestimonial(ctx context.Context, id int64) error {
+	return r.withOutbox(ctx, models.EntityTestimonial, events.ActionDeleted, func(tx *repository) (int64, error) {
+		return id, checkRowsAffected(tx.db.WithContext(ctx).Delete(&models.Testimonial{}, id))
+	})
+}
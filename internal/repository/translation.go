@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/translation"
+	"gorm.io/gorm/clause"
+)
+
+// translatableSource is the table of an entity with translatable fields and
+// the expression naming its records in reports
+type translatableSource struct {
+	model interface{}
+	table string
+	title string
+}
+
+var translatableSources = map[string]translatableSource{
+	models.EntityProfile:          {&models.Profile{}, "portfolio.profile", "full_name"},
+	models.EntityWorkExperience:   {&models.WorkExperience{}, "portfolio.work_experience", "concat_ws(' at ', position, company)"},
+	models.EntityPortfolioProject: {&models.PortfolioProject{}, "portfolio.portfolio_projects", "title"},
+	models.EntityMiniatureProject: {&models.MiniatureProject{}, "miniatures.miniature_projects", "title"},
+}
+
+// GetTranslations returns the translated fields of one record by locale
+func (r *repository) GetTranslations(ctx context.Context, entity string, id int64) (*models.Translations, error) {
+	if err := r.checkTranslatable(ctx, entity, id); err != nil {
+		return nil, err
+	}
+	var rows []models.Translation
+	if err := r.db.WithContext(ctx).Where("entity = ? AND entity_id = ?", entity, id).Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to get translations of %s %d: %w", entity, id, err)
+	}
+
+	translations := &models.Translations{Entity: entity, EntityID: id, Locales: map[string]map[string]interface{}{}}
+	for _, row := range rows {
+		if translations.Locales[row.Locale] == nil {
+			translations.Locales[row.Locale] = map[string]interface{}{}
+		}
+		translations.Locales[row.Locale][row.Field] = translation.Decode(entity, row.Field, row.Value)
+	}
+	return translations, nil
+}
+
+// GetTranslationValues returns the stored values of records of entity in
+// locale, by record ID and field
+func (r *repository) GetTranslationValues(ctx context.Context, entity, locale string, ids []int64) (map[int64]map[string]string, error) {
+	values := make(map[int64]map[string]string)
+	if len(ids) == 0 {
+		return values, nil
+	}
+	var rows []models.Translation
+	err := r.db.WithContext(ctx).
+		Where("entity = ? AND locale = ? AND entity_id IN ?", entity, locale, ids).
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s translations of %s: %w", locale, entity, err)
+	}
+	for _, row := range rows {
+		if values[row.EntityID] == nil {
+			values[row.EntityID] = map[string]string{}
+		}
+		values[row.EntityID][row.Field] = row.Value
+	}
+	return values, nil
+}
+
+// SetTranslations replaces the translations of one record in locale with
+// values, by field. Fields not in values lose their translation. Changing
+// them updates the record.
+func (r *repository) SetTranslations(ctx context.Context, entity string, id int64, locale string, values map[string]string) error {
+	return r.withOutbox(ctx, entity, events.ActionUpdated, func(tx *repository) (int64, error) {
+		if err := tx.checkTranslatable(ctx, entity, id); err != nil {
+			return id, err
+		}
+		db := tx.db.WithContext(ctx)
+
+		kept := make([]string, 0, len(values))
+		rows := make([]models.Translation, 0, len(values))
+		for field, value := range values {
+			kept = append(kept, field)
+			rows = append(rows, models.Translation{Entity: entity, EntityID: id, Field: field, Locale: locale, Value: value})
+		}
+
+		stale := db.Where("entity = ? AND entity_id = ? AND locale = ?", entity, id, locale)
+		if len(kept) > 0 {
+			stale = stale.Where("field NOT IN ?", kept)
+		}
+		if err := stale.Delete(&models.Translation{}).Error; err != nil {
+			return id, fmt.Errorf("failed to remove %s translations of %s %d: %w", locale, entity, id, err)
+		}
+		if len(rows) == 0 {
+			return id, nil
+		}
+
+		err := db.Omit("ID").Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "entity"}, {Name: "entity_id"}, {Name: "field"}, {Name: "locale"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at"}),
+		}).Create(&rows).Error
+		if err != nil {
+			return id, fmt.Errorf("failed to save %s translations of %s %d: %w", locale, entity, id, err)
+		}
+		return id, nil
+	})
+}
+
+// GetMissingTranslations lists, per record of entity and locale, the fields
+// that have text in the default locale but no translation. Records are
+// ordered by ID, locales as given.
+func (r *repository) GetMissingTranslations(ctx context.Context, entity string, locales []string) ([]models.MissingTranslation, error) {
+	source, ok := translatableSources[entity]
+	if !ok {
+		return nil, fmt.Errorf("entity %s has no translatable fields", entity)
+	}
+	db := r.db.WithContext(ctx)
+
+	// One row per record and field with text to translate
+	fields := translation.Fields(entity)
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		filled := fmt.Sprintf("coalesce(%s, '') <> ''", field.Column)
+		if field.List {
+			filled = fmt.Sprintf("coalesce(%s, 'null'::jsonb) NOT IN ('[]'::jsonb, 'null'::jsonb)", field.Column)
+		}
+		parts[i] = fmt.Sprintf("SELECT id, %s AS title, '%s' AS field, %d AS position FROM %s WHERE %s",
+			source.title, field.Name, i, source.table, filled)
+	}
+	var filled []struct {
+		ID    int64
+		Title string
+		Field string
+	}
+	if err := db.Raw(strings.Join(parts, " UNION ALL ") + " ORDER BY id, position").Scan(&filled).Error; err != nil {
+		return nil, fmt.Errorf("failed to get translatable fields of %s: %w", entity, err)
+	}
+
+	var stored []models.Translation
+	if err := db.Select("entity_id", "field", "locale").Where("entity = ? AND locale IN ?", entity, locales).Find(&stored).Error; err != nil {
+		return nil, fmt.Errorf("failed to get translations of %s: %w", entity, err)
+	}
+	translated := make(map[string]bool, len(stored))
+	for _, row := range stored {
+		translated[fmt.Sprintf("%d/%s/%s", row.EntityID, row.Locale, row.Field)] = true
+	}
+
+	missing := []models.MissingTranslation{}
+	index := make(map[string]int)
+	for _, row := range filled {
+		for _, locale := range locales {
+			if translated[fmt.Sprintf("%d/%s/%s", row.ID, locale, row.Field)] {
+				continue
+			}
+			key := fmt.Sprintf("%d/%s", row.ID, locale)
+			i, ok := index[key]
+			if !ok {
+				i = len(missing)
+				index[key] = i
+				missing = append(missing, models.MissingTranslation{Entity: entity, EntityID: row.ID, Title: row.Title, Locale: locale})
+			}
+			missing[i].Fields = append(missing[i].Fields, row.Field)
+		}
+	}
+	return missing, nil
+}
+
+// untranslate removes the translations of a record being deleted
+func (r *repository) untranslate(ctx context.Context, entity string, id int64) error {
+	if _, ok := translatableSources[entity]; !ok {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Where("entity = ? AND entity_id = ?", entity, id).Delete(&models.Translation{}).Error; err != nil {
+		return fmt.Errorf("failed to remove translations of %s %d: %w", entity, id, err)
+	}
+	return nil
+}
+
+// checkTranslatable verifies entity has translatable fields and the record exists
+func (r *repository) checkTranslatable(ctx context.Context, entity string, id int64) error {
+	source, ok := translatableSources[entity]
+	if !ok {
+		return fmt.Errorf("entity %s has no translatable fields", entity)
+	}
+	return r.checkRecord(ctx, entity, source.model, id)
+}
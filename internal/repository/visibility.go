@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"gorm.io/gorm"
+)
+
+// visibleSources maps the entity types that can be hidden from the public
+// site to their records, which have an is_visible column
+var visibleSources = map[string]interface{}{
+	models.EntityPortfolioProject: &models.PortfolioProject{},
+	models.EntityMiniatureProject: &models.MiniatureProject{},
+}
+
+// GetEntityVisibility returns whether one record is shown on the public site
+func (r *repository) GetEntityVisibility(ctx context.Context, entity string, id int64) (*models.Visibility, error) {
+	model, ok := visibleSources[entity]
+	if !ok {
+		return nil, fmt.Errorf("entity %s has no visibility", entity)
+	}
+	var visible []bool
+	if err := r.db.WithContext(ctx).Model(model).Where("id = ?", id).Pluck("is_visible", &visible).Error; err != nil {
+		return nil, fmt.Errorf("failed to get visibility of %s %d: %w", entity, id, err)
+	}
+	if len(visible) == 0 {
+		return nil, fmt.Errorf("failed to get %s with id %d: %w", entity, id, gorm.ErrRecordNotFound)
+	}
+	return &models.Visibility{Visible: &visible[0]}, nil
+}
+
+// SetEntityVisibility shows or hides one record on the public site
+func (r *repository) SetEntityVisibility(ctx context.Context, entity string, id int64, visible bool) error {
+	return r.withOutbox(ctx, entity, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return id, tx.setVisibility(ctx, entity, id, visible)
+	})
+}
+
+// setVisibility writes the is_visible column of one record
+func (r *repository) setVisibility(ctx context.Context, entity string, id int64, visible bool) error {
+	model, ok := visibleSources[entity]
+	if !ok {
+		return fmt.Errorf("entity %s has no visibility", entity)
+	}
+	return checkRowsAffected(r.db.WithContext(ctx).Model(model).Where("id = ?", id).UpdateColumn("is_visible", visible))
+}
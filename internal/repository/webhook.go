@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+func (r *repository) GetAllWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	err := r.db.WithContext(ctx).Order("name ASC").Find(&webhooks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+func (r *repository) GetWebhookByID(ctx context.Context, id int64) (*models.Webhook, error) {
+	var webhook models.Webhook
+	err := r.db.WithContext(ctx).First(&webhook, id).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook with id %d: %w", id, err)
+	}
+	return &webhook, nil
+}
+
+// GetActiveWebhooks returns all webhooks that are not disabled
+func (r *repository) GetActiveWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	var webhooks []models.Webhook
+	err := r.db.WithContext(ctx).Where("disabled = ?", false).Find(&webhooks).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active webhooks: %w", err)
+	}
+	return webhooks, nil
+}
+
+func (r *repository) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(webhook).Error
+	if err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return nil
+}
+
+// UpdateWebhook updates a webhook. An empty secret keeps the stored one.
+func (r *repository) UpdateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	if webhook.Secret == "" {
+		existing, err := r.GetWebhookByID(ctx, webhook.ID)
+		if err != nil {
+			return err
+		}
+		webhook.Secret = existing.Secret
+	}
+	return r.safeUpdate(ctx, webhook, webhook.ID)
+}
+
+func (r *repository) DeleteWebhook(ctx context.Context, id int64) error {
+	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.Webhook{}, id))
+}
+
+// GetWebhookDeliveries returns the most recent deliveries of a webhook
+func (r *repository) GetWebhookDeliveries(ctx context.Context, webhookID int64, limit int) ([]models.WebhookDelivery, error) {
+	var deliveries []models.WebhookDelivery
+	err := r.db.WithContext(ctx).
+		Where("webhook_id = ?", webhookID).
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&deliveries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deliveries for webhook %d: %w", webhookID, err)
+	}
+	return deliveries, nil
+}
+
+// GetDueWebhookDeliveries returns pending deliveries whose next attempt is
+// due, oldest first, skipping the webhooks in excludeWebhookIDs
+func (r *repository) GetDueWebhookDeliveries(ctx context.Context, now time.Time, excludeWebhookIDs []int64, limit int) ([]models.WebhookDelivery, error) {
+	query := r.db.WithContext(ctx).
+		Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, now)
+	if len(excludeWebhookIDs) > 0 {
+		query = query.Where("webhook_id NOT IN ?", excludeWebhookIDs)
+	}
+
+	var deliveries []models.WebhookDelivery
+	err := query.Order("next_attempt_at ASC, id ASC").Limit(limit).Find(&deliveries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// enqueueWebhookDeliveries records a pending delivery of the event for every
+// active webhook subscribed to it. It runs in the transaction of the change,
+// so deliveries exist if and only if the change does.
+func (r *repository) enqueueWebhookDeliveries(ctx context.Context, event events.Event, payload []byte) error {
+	hooks, err := r.GetActiveWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+	deliveries := events.NewDeliveries(hooks, event, payload)
+	if len(deliveries) == 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(&deliveries).Error; err != nil {
+		return fmt.Errorf("failed to record webhook deliveries of %s: %w", event.Type, err)
+	}
+	return nil
+}
+
+func (r *repository) CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(delivery).Error
+	if err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+	return nil
+}
+
+func (r *repository) UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	return r.safeUpdate(ctx, delivery, delivery.ID)
+}
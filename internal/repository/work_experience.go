@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
 )
 
@@ -26,17 +27,25 @@ func (r *repository) GetWorkExperienceByID(ctx context.Context, id int64) (*mode
 }
 
 func (r *repository) CreateWorkExperience(ctx context.Context, exp *models.WorkExperience) error {
-	err := r.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(exp).Error
-	if err != nil {
-		return fmt.Errorf("failed to create work experience: %w", err)
-	}
-	return nil
+	return r.withOutbox(ctx, models.EntityWorkExperience, events.ActionCreated, func(tx *repository) (int64, error) {
+		if err := tx.db.WithContext(ctx).Omit("ID", "CreatedAt", "UpdatedAt").Create(exp).Error; err != nil {
+			return 0, fmt.Errorf("failed to create work experience: %w", err)
+		}
+		return exp.ID, nil
+	})
 }
 
 func (r *repository) UpdateWorkExperience(ctx context.Context, exp *models.WorkExperience) error {
-	return r.safeUpdate(ctx, exp, exp.ID)
+	return r.withOutbox(ctx, models.EntityWorkExperience, events.ActionUpdated, func(tx *repository) (int64, error) {
+		return exp.ID, tx.safeUpdate(ctx, exp, exp.ID)
+	})
 }
 
 func (r *repository) DeleteWorkExperience(ctx context.Context, id int64) error {
-	return checkRowsAffected(r.db.WithContext(ctx).Delete(&models.WorkExperience{}, id))
+	return r.withOutbox(ctx, models.EntityWorkExperience, events.ActionDeleted, func(tx *repository) (int64, error) {
+		if err := tx.detach(ctx, models.EntityWorkExperience, id); err != nil {
+			return id, err
+		}
+		return id, checkRowsAffected(tx.db.WithContext(ctx).Delete(&models.WorkExperience{}, id))
+	})
 }
@@ -0,0 +1,42 @@
+package routes
+
+import (
+	"net/http"
+
+	common "github.com/GunarsK-portfolio/portfolio-common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// requireAnyPermission is common.RequirePermission for records shared by
+// several resources: access at level on any one of them is enough
+func requireAnyPermission(level string, resources ...string) gin.HandlerFunc {
+	if !common.ValidLevel(level) {
+		panic("routes: invalid permission level: " + level)
+	}
+	return func(c *gin.Context) {
+		scopes, exists := c.Get(common.CtxKeyScopes)
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			return
+		}
+
+		scopesMap, ok := scopes.(map[string]string)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "invalid scopes format"})
+			return
+		}
+
+		for _, resource := range resources {
+			if common.HasPermission(scopesMap[resource], level) {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+			"error":     "insufficient permissions",
+			"resources": resources,
+			"required":  level,
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/GunarsK-portfolio/admin-api/docs"
 	"github.com/GunarsK-portfolio/admin-api/internal/config"
 	"github.com/GunarsK-portfolio/admin-api/internal/handlers"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
 	"github.com/GunarsK-portfolio/portfolio-common/health"
 	"github.com/GunarsK-portfolio/portfolio-common/jwt"
 	"github.com/GunarsK-portfolio/portfolio-common/metrics"
@@ -49,9 +50,15 @@ func Setup(router *gin.Engine, handler *handlers.Handler, cfg *config.Config, me
 			portfolio.GET("/profile", common.RequirePermission(common.ResourceProfile, common.LevelRead), handler.GetProfile)
 			portfolio.PUT("/profile", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UpdateProfile)
 			portfolio.PUT("/profile/avatar", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UpdateProfileAvatar)
+			portfolio.POST("/profile/avatar/upload", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UploadProfileAvatar)
 			portfolio.DELETE("/profile/avatar", common.RequirePermission(common.ResourceProfile, common.LevelDelete), handler.DeleteProfileAvatar)
 			portfolio.PUT("/profile/resume", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UpdateProfileResume)
+			portfolio.POST("/profile/resume/upload", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UploadProfileResume)
 			portfolio.DELETE("/profile/resume", common.RequirePermission(common.ResourceProfile, common.LevelDelete), handler.DeleteProfileResume)
+			portfolio.GET("/profile/seo", common.RequirePermission(common.ResourceProfile, common.LevelRead), handler.GetProfileSEO)
+			portfolio.PUT("/profile/seo", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.SetProfileSEO)
+			portfolio.GET("/profile/translations", common.RequirePermission(common.ResourceProfile, common.LevelRead), handler.GetProfileTranslations)
+			portfolio.PUT("/profile/translations/:locale", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.SetProfileTranslations)
 
 			// Work Experience
 			portfolio.GET("/experience", common.RequirePermission(common.ResourceExperience, common.LevelRead), handler.GetAllWorkExperience)
@@ -59,6 +66,8 @@ func Setup(router *gin.Engine, handler *handlers.Handler, cfg *config.Config, me
 			portfolio.GET("/experience/:id", common.RequirePermission(common.ResourceExperience, common.LevelRead), handler.GetWorkExperienceByID)
 			portfolio.PUT("/experience/:id", common.RequirePermission(common.ResourceExperience, common.LevelEdit), handler.UpdateWorkExperience)
 			portfolio.DELETE("/experience/:id", common.RequirePermission(common.ResourceExperience, common.LevelDelete), handler.DeleteWorkExperience)
+			portfolio.GET("/experience/:id/translations", common.RequirePermission(common.ResourceExperience, common.LevelRead), handler.GetWorkExperienceTranslations)
+			portfolio.PUT("/experience/:id/translations/:locale", common.RequirePermission(common.ResourceExperience, common.LevelEdit), handler.SetWorkExperienceTranslations)
 
 			// Certifications
 			portfolio.GET("/certifications", common.RequirePermission(common.ResourceCertifications, common.LevelRead), handler.GetAllCertifications)
@@ -67,12 +76,27 @@ func Setup(router *gin.Engine, handler *handlers.Handler, cfg *config.Config, me
 			portfolio.PUT("/certifications/:id", common.RequirePermission(common.ResourceCertifications, common.LevelEdit), handler.UpdateCertification)
 			portfolio.DELETE("/certifications/:id", common.RequirePermission(common.ResourceCertifications, common.LevelDelete), handler.DeleteCertification)
 
+			// Education
+			portfolio.GET("/education", common.RequirePermission(models.ResourceEducation, common.LevelRead), handler.GetAllEducation)
+			portfolio.POST("/education", common.RequirePermission(models.ResourceEducation, common.LevelEdit), handler.CreateEducation)
+			portfolio.GET("/education/:id", common.RequirePermission(models.ResourceEducation, common.LevelRead), handler.GetEducationByID)
+			portfolio.PUT("/education/:id", common.RequirePermission(models.ResourceEducation, common.LevelEdit), handler.UpdateEducation)
+			portfolio.DELETE("/education/:id", common.RequirePermission(models.ResourceEducation, common.LevelDelete), handler.DeleteEducation)
+
+			// Testimonials
+			portfolio.GET("/testimonials", common.RequirePermission(models.ResourceTestimonials, common.LevelRead), handler.GetAllTestimonials)
+			portfolio.POST("/testimonials", common.RequirePermission(models.ResourceTestimonials, common.LevelEdit), handler.CreateTestimonial)
+			portfolio.GET("/testimonials/:id", common.RequirePermission(models.ResourceTestimonials, common.LevelRead), handler.GetTestimonialByID)
+			portfolio.PUT("/testimonials/:id", common.RequirePermission(models.ResourceTestimonials, common.LevelEdit), handler.UpdateTestimonial)
+			portfolio.DELETE("/testimonials/:id", common.RequirePermission(models.ResourceTestimonials, common.LevelDelete), handler.DeleteTestimonial)
+
 			// Skills
 			portfolio.GET("/skills", common.RequirePermission(common.ResourceSkills, common.LevelRead), handler.GetAllSkills)
 			portfolio.POST("/skills", common.RequirePermission(common.ResourceSkills, common.LevelEdit), handler.CreateSkill)
 			portfolio.GET("/skills/:id", common.RequirePermission(common.ResourceSkills, common.LevelRead), handler.GetSkillByID)
 			portfolio.PUT("/skills/:id", common.RequirePermission(common.ResourceSkills, common.LevelEdit), handler.UpdateSkill)
 			portfolio.DELETE("/skills/:id", common.RequirePermission(common.ResourceSkills, common.LevelDelete), handler.DeleteSkill)
+			portfolio.POST("/skills/:id/merge", common.RequirePermission(common.ResourceSkills, common.LevelDelete), handler.MergeSkills)
 
 			// Skill Types
 			portfolio.GET("/skill-types", common.RequirePermission(common.ResourceSkills, common.LevelRead), handler.GetAllSkillTypes)
@@ -80,13 +104,26 @@ func Setup(router *gin.Engine, handler *handlers.Handler, cfg *config.Config, me
 			portfolio.GET("/skill-types/:id", common.RequirePermission(common.ResourceSkills, common.LevelRead), handler.GetSkillTypeByID)
 			portfolio.PUT("/skill-types/:id", common.RequirePermission(common.ResourceSkills, common.LevelEdit), handler.UpdateSkillType)
 			portfolio.DELETE("/skill-types/:id", common.RequirePermission(common.ResourceSkills, common.LevelDelete), handler.DeleteSkillType)
+			portfolio.POST("/skill-types/:id/merge", common.RequirePermission(common.ResourceSkills, common.LevelDelete), handler.MergeSkillTypes)
 
 			// Portfolio Projects
 			portfolio.GET("/projects", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetAllPortfolioProjects)
 			portfolio.POST("/projects", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.CreatePortfolioProject)
 			portfolio.GET("/projects/:id", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectByID)
+			portfolio.GET("/projects/slug/:slug", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectBySlug)
 			portfolio.PUT("/projects/:id", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.UpdatePortfolioProject)
 			portfolio.DELETE("/projects/:id", common.RequirePermission(common.ResourceProjects, common.LevelDelete), handler.DeletePortfolioProject)
+			portfolio.POST("/projects/:id/clone", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.ClonePortfolioProject)
+			portfolio.GET("/projects/:id/tags", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectTags)
+			portfolio.PUT("/projects/:id/tags", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectTags)
+			portfolio.GET("/projects/:id/slugs", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectSlugs)
+			portfolio.PUT("/projects/:id/slug", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectSlug)
+			portfolio.GET("/projects/:id/visibility", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectVisibility)
+			portfolio.PUT("/projects/:id/visibility", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectVisibility)
+			portfolio.GET("/projects/:id/seo", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectSEO)
+			portfolio.PUT("/projects/:id/seo", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectSEO)
+			portfolio.GET("/projects/:id/translations", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectTranslations)
+			portfolio.PUT("/projects/:id/translations/:locale", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectTranslations)
 		}
 
 		// Miniatures domain
@@ -96,32 +133,120 @@ func Setup(router *gin.Engine, handler *handlers.Handler, cfg *config.Config, me
 			miniatures.GET("/themes", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetAllMiniatureThemes)
 			miniatures.POST("/themes", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.CreateMiniatureTheme)
 			miniatures.GET("/themes/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeByID)
+			miniatures.GET("/themes/slug/:slug", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeBySlug)
 			miniatures.PUT("/themes/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.UpdateMiniatureTheme)
 			miniatures.DELETE("/themes/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelDelete), handler.DeleteMiniatureTheme)
+			miniatures.GET("/themes/:id/tags", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeTags)
+			miniatures.PUT("/themes/:id/tags", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureThemeTags)
+			miniatures.GET("/themes/:id/slugs", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeSlugs)
+			miniatures.PUT("/themes/:id/slug", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureThemeSlug)
+			miniatures.GET("/themes/:id/seo", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeSEO)
+			miniatures.PUT("/themes/:id/seo", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureThemeSEO)
 
 			// Miniature Projects
 			miniatures.GET("/projects", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetAllMiniatureProjects)
 			miniatures.POST("/projects", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.CreateMiniatureProject)
 			miniatures.GET("/projects/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectByID)
+			miniatures.GET("/projects/slug/:slug", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectBySlug)
 			miniatures.PUT("/projects/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.UpdateMiniatureProject)
 			miniatures.DELETE("/projects/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelDelete), handler.DeleteMiniatureProject)
+			miniatures.POST("/projects/:id/clone", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.CloneMiniatureProject)
 			miniatures.POST("/projects/:id/images", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.AddImageToProject)
+			miniatures.POST("/projects/:id/images/upload", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.UploadProjectImage)
 			miniatures.PUT("/projects/:id/techniques", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetProjectTechniques)
 			miniatures.PUT("/projects/:id/paints", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetProjectPaints)
+			miniatures.GET("/projects/:id/tags", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectTags)
+			miniatures.PUT("/projects/:id/tags", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectTags)
+			miniatures.GET("/projects/:id/slugs", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectSlugs)
+			miniatures.PUT("/projects/:id/slug", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectSlug)
+			miniatures.GET("/projects/:id/visibility", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectVisibility)
+			miniatures.PUT("/projects/:id/visibility", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectVisibility)
+			miniatures.GET("/projects/:id/seo", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectSEO)
+			miniatures.PUT("/projects/:id/seo", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectSEO)
+			miniatures.GET("/projects/:id/translations", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectTranslations)
+			miniatures.PUT("/projects/:id/translations/:locale", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectTranslations)
 
 			// Miniature Techniques
 			miniatures.GET("/techniques", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetAllTechniques)
 
+			// Miniature Stats
+			miniatures.GET("/stats", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureStats)
+
 			// Miniature Paints
 			miniatures.GET("/paints", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetAllMiniaturePaints)
 			miniatures.POST("/paints", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.CreateMiniaturePaint)
 			miniatures.GET("/paints/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniaturePaintByID)
 			miniatures.PUT("/paints/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.UpdateMiniaturePaint)
 			miniatures.DELETE("/paints/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelDelete), handler.DeleteMiniaturePaint)
+			miniatures.POST("/paints/:id/merge", common.RequirePermission(common.ResourceMiniatures, common.LevelDelete), handler.MergeMiniaturePaints)
+		}
+
+		// Blog domain
+		blog := v1.Group("/blog")
+		{
+			// Articles
+			blog.GET("/articles", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetAllArticles)
+			blog.POST("/articles", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.CreateArticle)
+			blog.GET("/articles/:id", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleByID)
+			blog.GET("/articles/slug/:slug", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleBySlug)
+			blog.PUT("/articles/:id", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.UpdateArticle)
+			blog.DELETE("/articles/:id", common.RequirePermission(models.ResourceBlog, common.LevelDelete), handler.DeleteArticle)
+			blog.PUT("/articles/:id/skills", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.SetArticleSkills)
+			blog.GET("/articles/:id/tags", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleTags)
+			blog.PUT("/articles/:id/tags", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.SetArticleTags)
+			blog.GET("/articles/:id/slugs", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleSlugs)
+			blog.PUT("/articles/:id/slug", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.SetArticleSlug)
+			blog.GET("/articles/:id/seo", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleSEO)
+			blog.PUT("/articles/:id/seo", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.SetArticleSEO)
 		}
 
-		// Files (generic file deletion - requires delete permission on files resource)
+		// Tags (shared by portfolio, miniatures and blog - any of the resources grants access)
+		tags := v1.Group("/tags")
+		{
+			tags.GET("", requireAnyPermission(common.LevelRead, models.TagResources...), handler.GetAllTags)
+			tags.POST("", requireAnyPermission(common.LevelEdit, models.TagResources...), handler.CreateTag)
+			tags.GET("/:id", requireAnyPermission(common.LevelRead, models.TagResources...), handler.GetTagByID)
+			tags.PUT("/:id", requireAnyPermission(common.LevelEdit, models.TagResources...), handler.UpdateTag)
+			tags.DELETE("/:id", requireAnyPermission(common.LevelDelete, models.TagResources...), handler.DeleteTag)
+		}
+
+		// Dashboard (content overview - filtered per resource by the caller's read permissions)
+		v1.GET("/dashboard", handler.GetDashboard)
+
+		// Full-text search (filtered per resource by the caller's read permissions)
+		v1.GET("/search", handler.Search)
+
+		// SEO check (filtered per resource by the caller's read permissions)
+		v1.GET("/seo/check", handler.CheckSEO)
+
+		// Missing translations (filtered per resource by the caller's read permissions)
+		v1.GET("/translations/missing", handler.GetMissingTranslations)
+
+		// Markdown preview (renders request bodies only, reads no resources)
+		v1.POST("/render/markdown", handler.RenderMarkdown)
+
+		// Live change stream (SSE - filtered per resource by the caller's read permissions)
+		v1.GET("/events/stream", handler.StreamEvents)
+
+		// Webhooks (outbound content change notifications)
+		webhooks := v1.Group("/webhooks")
+		{
+			webhooks.GET("", common.RequirePermission(models.ResourceWebhooks, common.LevelRead), handler.GetAllWebhooks)
+			webhooks.POST("", common.RequirePermission(models.ResourceWebhooks, common.LevelEdit), handler.CreateWebhook)
+			webhooks.GET("/:id", common.RequirePermission(models.ResourceWebhooks, common.LevelRead), handler.GetWebhookByID)
+			webhooks.PUT("/:id", common.RequirePermission(models.ResourceWebhooks, common.LevelEdit), handler.UpdateWebhook)
+			webhooks.DELETE("/:id", common.RequirePermission(models.ResourceWebhooks, common.LevelDelete), handler.DeleteWebhook)
+			webhooks.GET("/:id/deliveries", common.RequirePermission(models.ResourceWebhooks, common.LevelRead), handler.GetWebhookDeliveries)
+			webhooks.POST("/:id/test", common.RequirePermission(models.ResourceWebhooks, common.LevelEdit), handler.SendWebhookTestEvent)
+		}
+
+		// Cache (manual public API cache purge)
+		v1.POST("/cache/purge", common.RequirePermission(models.ResourceCache, common.LevelEdit), handler.PurgeCache)
+
+		// Files (generic file deletion and orphan cleanup - files resource)
 		v1.DELETE("/files/:id", common.RequirePermission(common.ResourceFiles, common.LevelDelete), handler.DeleteImage)
+		v1.GET("/files/orphans", common.RequirePermission(common.ResourceFiles, common.LevelRead), handler.GetOrphanedFiles)
+		v1.POST("/files/orphans/purge", common.RequirePermission(common.ResourceFiles, common.LevelDelete), handler.PurgeOrphanedFiles)
 	}
 
 	// Swagger documentation (only if SWAGGER_HOST is configured)
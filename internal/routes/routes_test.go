@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/GunarsK-portfolio/admin-api/internal/handlers"
 	"github.com/GunarsK-portfolio/admin-api/internal/models"
@@ -47,36 +48,40 @@ type mockRepository struct {
 	updateCertificationFunc  func(ctx context.Context, cert *models.Certification) error
 	deleteCertificationFunc  func(ctx context.Context, id int64) error
 
+	// Certification reminders
+	getCertificationRemindersFunc   func(ctx context.Context) ([]models.CertificationReminder, error)
+	createCertificationReminderFunc func(ctx context.Context, reminder *models.CertificationReminder) error
+
 	// Skills
 	getAllSkillsFunc func(ctx context.Context) ([]models.Skill, error)
 	getSkillByIDFunc func(ctx context.Context, id int64) (*models.Skill, error)
 	createSkillFunc  func(ctx context.Context, skill *models.Skill) error
 	updateSkillFunc  func(ctx context.Context, skill *models.Skill) error
-	deleteSkillFunc  func(ctx context.Context, id int64) error
+	deleteSkillFunc  func(ctx context.Context, id int64, opts models.DeleteOptions) error
 
 	// Skill Types
 	getAllSkillTypesFunc func(ctx context.Context) ([]models.SkillType, error)
 	getSkillTypeByIDFunc func(ctx context.Context, id int64) (*models.SkillType, error)
 	createSkillTypeFunc  func(ctx context.Context, skillType *models.SkillType) error
 	updateSkillTypeFunc  func(ctx context.Context, skillType *models.SkillType) error
-	deleteSkillTypeFunc  func(ctx context.Context, id int64) error
+	deleteSkillTypeFunc  func(ctx context.Context, id int64, opts models.DeleteOptions) error
 
 	// Portfolio Projects
-	getAllPortfolioProjectsFunc func(ctx context.Context) ([]models.PortfolioProject, error)
+	getAllPortfolioProjectsFunc func(ctx context.Context, filter models.ListFilter) ([]models.PortfolioProject, error)
 	getPortfolioProjectByIDFunc func(ctx context.Context, id int64) (*models.PortfolioProject, error)
 	createPortfolioProjectFunc  func(ctx context.Context, project *models.PortfolioProject) error
 	updatePortfolioProjectFunc  func(ctx context.Context, project *models.PortfolioProject) error
 	deletePortfolioProjectFunc  func(ctx context.Context, id int64) error
 
 	// Miniature Themes
-	getAllMiniatureThemesFunc func(ctx context.Context) ([]models.MiniatureTheme, error)
+	getAllMiniatureThemesFunc func(ctx context.Context, filter models.ListFilter) ([]models.MiniatureTheme, error)
 	getMiniatureThemeByIDFunc func(ctx context.Context, id int64) (*models.MiniatureTheme, error)
 	createMiniatureThemeFunc  func(ctx context.Context, theme *models.MiniatureTheme) error
 	updateMiniatureThemeFunc  func(ctx context.Context, theme *models.MiniatureTheme) error
-	deleteMiniatureThemeFunc  func(ctx context.Context, id int64) error
+	deleteMiniatureThemeFunc  func(ctx context.Context, id int64, opts models.DeleteOptions) error
 
 	// Miniature Projects
-	getAllMiniatureProjectsFunc func(ctx context.Context) ([]models.MiniatureProject, error)
+	getAllMiniatureProjectsFunc func(ctx context.Context, filter models.ListFilter) ([]models.MiniatureProject, error)
 	getMiniatureProjectByIDFunc func(ctx context.Context, id int64) (*models.MiniatureProject, error)
 	createMiniatureProjectFunc  func(ctx context.Context, project *models.MiniatureProject) error
 	updateMiniatureProjectFunc  func(ctx context.Context, project *models.MiniatureProject) error
@@ -88,15 +93,109 @@ type mockRepository struct {
 	// Miniature Techniques
 	getAllTechniquesFunc func(ctx context.Context) ([]models.MiniatureTechnique, error)
 
+	// Miniature Stats
+	getMiniatureStatsFunc func(ctx context.Context, filter models.MiniatureStatsFilter) (*models.MiniatureStats, error)
+
 	// Miniature Paints
 	getAllMiniaturePaintsFunc func(ctx context.Context) ([]models.MiniaturePaint, error)
 	getMiniaturePaintByIDFunc func(ctx context.Context, id int64) (*models.MiniaturePaint, error)
 	createMiniaturePaintFunc  func(ctx context.Context, paint *models.MiniaturePaint) error
 	updateMiniaturePaintFunc  func(ctx context.Context, paint *models.MiniaturePaint) error
-	deleteMiniaturePaintFunc  func(ctx context.Context, id int64) error
+	deleteMiniaturePaintFunc  func(ctx context.Context, id int64, opts models.DeleteOptions) error
 
 	// Images/Files
 	deleteImageFunc func(ctx context.Context, id int64) error
+
+	// Dashboard
+	getDashboardFunc func(ctx context.Context, expiringWithinDays int) (*models.Dashboard, error)
+
+	// Webhooks
+	getAllWebhooksFunc          func(ctx context.Context) ([]models.Webhook, error)
+	getWebhookByIDFunc          func(ctx context.Context, id int64) (*models.Webhook, error)
+	getActiveWebhooksFunc       func(ctx context.Context) ([]models.Webhook, error)
+	createWebhookFunc           func(ctx context.Context, webhook *models.Webhook) error
+	updateWebhookFunc           func(ctx context.Context, webhook *models.Webhook) error
+	deleteWebhookFunc           func(ctx context.Context, id int64) error
+	getWebhookDeliveriesFunc    func(ctx context.Context, webhookID int64, limit int) ([]models.WebhookDelivery, error)
+	getDueWebhookDeliveriesFunc func(ctx context.Context, now time.Time, excludeWebhookIDs []int64, limit int) ([]models.WebhookDelivery, error)
+	createWebhookDeliveryFunc   func(ctx context.Context, delivery *models.WebhookDelivery) error
+	updateWebhookDeliveryFunc   func(ctx context.Context, delivery *models.WebhookDelivery) error
+
+	// Outbox
+	getPendingOutboxEventsFunc      func(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	markOutboxEventPublishedFunc    func(ctx context.Context, id int64, publishedAt time.Time) error
+	recordOutboxEventFailureFunc    func(ctx context.Context, id int64, lastError string) error
+	deletePublishedOutboxEventsFunc func(ctx context.Context, before time.Time) (int64, error)
+
+	// Storage Files
+	getStorageFileByIDFunc func(ctx context.Context, id int64) (*models.StorageFile, error)
+
+	// Orphaned Files
+	getOrphanedFilesFunc func(ctx context.Context) ([]models.StorageFile, error)
+
+	// Merges
+	mergeSkillsFunc          func(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error)
+	mergeSkillTypesFunc      func(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error)
+	mergeMiniaturePaintsFunc func(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error)
+
+	// Clones
+	cloneMiniatureProjectFunc func(ctx context.Context, id int64, opts models.CloneOptions) (*models.MiniatureProject, error)
+	clonePortfolioProjectFunc func(ctx context.Context, id int64, opts models.CloneOptions) (*models.PortfolioProject, error)
+
+	// Search
+	searchFunc func(ctx context.Context, query models.SearchQuery) ([]models.SearchHit, error)
+
+	// Tags
+	getAllTagsFunc    func(ctx context.Context) ([]models.Tag, error)
+	getTagByIDFunc    func(ctx context.Context, id int64) (*models.Tag, error)
+	createTagFunc     func(ctx context.Context, tag *models.Tag) error
+	updateTagFunc     func(ctx context.Context, tag *models.Tag) error
+	deleteTagFunc     func(ctx context.Context, id int64) error
+	getEntityTagsFunc func(ctx context.Context, entity string, id int64) ([]models.Tag, error)
+	setEntityTagsFunc func(ctx context.Context, entity string, id int64, tagIDs []int64) error
+
+	// Slugs
+	getEntitySlugsFunc func(ctx context.Context, entity string, id int64) (*models.Slugs, error)
+	setEntitySlugFunc  func(ctx context.Context, entity string, id int64, value string) error
+	resolveSlugFunc    func(ctx context.Context, entity, value string) (*models.Slug, error)
+	backfillSlugsFunc  func(ctx context.Context) (int, error)
+
+	// Visibility
+	getEntityVisibilityFunc func(ctx context.Context, entity string, id int64) (*models.Visibility, error)
+	setEntityVisibilityFunc func(ctx context.Context, entity string, id int64, visible bool) error
+
+	// SEO
+	getSEOFunc    func(ctx context.Context, entity string, id int64) (*models.SEO, error)
+	getAllSEOFunc func(ctx context.Context, entity string) ([]models.SEO, error)
+	setSEOFunc    func(ctx context.Context, meta *models.SEOMetadata) error
+
+	// Translations
+	getTranslationsFunc        func(ctx context.Context, entity string, id int64) (*models.Translations, error)
+	getTranslationValuesFunc   func(ctx context.Context, entity, locale string, ids []int64) (map[int64]map[string]string, error)
+	setTranslationsFunc        func(ctx context.Context, entity string, id int64, locale string, values map[string]string) error
+	getMissingTranslationsFunc func(ctx context.Context, entity string, locales []string) ([]models.MissingTranslation, error)
+
+	// Education
+	getAllEducationFunc  func(ctx context.Context) ([]models.Education, error)
+	getEducationByIDFunc func(ctx context.Context, id int64) (*models.Education, error)
+	createEducationFunc  func(ctx context.Context, education *models.Education) error
+	updateEducationFunc  func(ctx context.Context, education *models.Education) error
+	deleteEducationFunc  func(ctx context.Context, id int64) error
+
+	// Testimonials
+	getAllTestimonialsFunc func(ctx context.Context, filter models.TestimonialFilter) ([]models.Testimonial, error)
+	getTestimonialByIDFunc func(ctx context.Context, id int64) (*models.Testimonial, error)
+	createTestimonialFunc  func(ctx context.Context, testimonial *models.Testimonial) error
+	updateTestimonialFunc  func(ctx context.Context, testimonial *models.Testimonial) error
+	deleteTestimonialFunc  func(ctx context.Context, id int64) error
+
+	// Articles
+	getAllArticlesFunc   func(ctx context.Context, filter models.ArticleFilter) ([]models.Article, error)
+	getArticleByIDFunc   func(ctx context.Context, id int64) (*models.Article, error)
+	createArticleFunc    func(ctx context.Context, article *models.Article, skillIDs []int64) error
+	updateArticleFunc    func(ctx context.Context, article *models.Article, skillIDs []int64) error
+	deleteArticleFunc    func(ctx context.Context, id int64) error
+	setArticleSkillsFunc func(ctx context.Context, articleID int64, skillIDs []int64) error
 }
 
 // Profile
@@ -214,6 +313,20 @@ func (m *mockRepository) DeleteCertification(ctx context.Context, id int64) erro
 	return nil
 }
 
+func (m *mockRepository) GetCertificationReminders(ctx context.Context) ([]models.CertificationReminder, error) {
+	if m.getCertificationRemindersFunc != nil {
+		return m.getCertificationRemindersFunc(ctx)
+	}
+	return []models.CertificationReminder{}, nil
+}
+
+func (m *mockRepository) CreateCertificationReminder(ctx context.Context, reminder *models.CertificationReminder) error {
+	if m.createCertificationReminderFunc != nil {
+		return m.createCertificationReminderFunc(ctx, reminder)
+	}
+	return nil
+}
+
 // Skills
 func (m *mockRepository) GetAllSkills(ctx context.Context) ([]models.Skill, error) {
 	if m.getAllSkillsFunc != nil {
@@ -243,9 +356,9 @@ func (m *mockRepository) UpdateSkill(ctx context.Context, skill *models.Skill) e
 	return nil
 }
 
-func (m *mockRepository) DeleteSkill(ctx context.Context, id int64) error {
+func (m *mockRepository) DeleteSkill(ctx context.Context, id int64, opts models.DeleteOptions) error {
 	if m.deleteSkillFunc != nil {
-		return m.deleteSkillFunc(ctx, id)
+		return m.deleteSkillFunc(ctx, id, opts)
 	}
 	return nil
 }
@@ -279,17 +392,17 @@ func (m *mockRepository) UpdateSkillType(ctx context.Context, skillType *models.
 	return nil
 }
 
-func (m *mockRepository) DeleteSkillType(ctx context.Context, id int64) error {
+func (m *mockRepository) DeleteSkillType(ctx context.Context, id int64, opts models.DeleteOptions) error {
 	if m.deleteSkillTypeFunc != nil {
-		return m.deleteSkillTypeFunc(ctx, id)
+		return m.deleteSkillTypeFunc(ctx, id, opts)
 	}
 	return nil
 }
 
 // Portfolio Projects
-func (m *mockRepository) GetAllPortfolioProjects(ctx context.Context) ([]models.PortfolioProject, error) {
+func (m *mockRepository) GetAllPortfolioProjects(ctx context.Context, filter models.ListFilter) ([]models.PortfolioProject, error) {
 	if m.getAllPortfolioProjectsFunc != nil {
-		return m.getAllPortfolioProjectsFunc(ctx)
+		return m.getAllPortfolioProjectsFunc(ctx, filter)
 	}
 	return []models.PortfolioProject{}, nil
 }
@@ -323,9 +436,9 @@ func (m *mockRepository) DeletePortfolioProject(ctx context.Context, id int64) e
 }
 
 // Miniature Themes
-func (m *mockRepository) GetAllMiniatureThemes(ctx context.Context) ([]models.MiniatureTheme, error) {
+func (m *mockRepository) GetAllMiniatureThemes(ctx context.Context, filter models.ListFilter) ([]models.MiniatureTheme, error) {
 	if m.getAllMiniatureThemesFunc != nil {
-		return m.getAllMiniatureThemesFunc(ctx)
+		return m.getAllMiniatureThemesFunc(ctx, filter)
 	}
 	return []models.MiniatureTheme{}, nil
 }
@@ -351,17 +464,17 @@ func (m *mockRepository) UpdateMiniatureTheme(ctx context.Context, theme *models
 	return nil
 }
 
-func (m *mockRepository) DeleteMiniatureTheme(ctx context.Context, id int64) error {
+func (m *mockRepository) DeleteMiniatureTheme(ctx context.Context, id int64, opts models.DeleteOptions) error {
 	if m.deleteMiniatureThemeFunc != nil {
-		return m.deleteMiniatureThemeFunc(ctx, id)
+		return m.deleteMiniatureThemeFunc(ctx, id, opts)
 	}
 	return nil
 }
 
 // Miniature Projects
-func (m *mockRepository) GetAllMiniatureProjects(ctx context.Context) ([]models.MiniatureProject, error) {
+func (m *mockRepository) GetAllMiniatureProjects(ctx context.Context, filter models.ListFilter) ([]models.MiniatureProject, error) {
 	if m.getAllMiniatureProjectsFunc != nil {
-		return m.getAllMiniatureProjectsFunc(ctx)
+		return m.getAllMiniatureProjectsFunc(ctx, filter)
 	}
 	return []models.MiniatureProject{}, nil
 }
@@ -423,6 +536,14 @@ func (m *mockRepository) GetAllTechniques(ctx context.Context) ([]models.Miniatu
 	return []models.MiniatureTechnique{}, nil
 }
 
+// Miniature Stats
+func (m *mockRepository) GetMiniatureStats(ctx context.Context, filter models.MiniatureStatsFilter) (*models.MiniatureStats, error) {
+	if m.getMiniatureStatsFunc != nil {
+		return m.getMiniatureStatsFunc(ctx, filter)
+	}
+	return &models.MiniatureStats{}, nil
+}
+
 // Miniature Paints
 func (m *mockRepository) GetAllMiniaturePaints(ctx context.Context) ([]models.MiniaturePaint, error) {
 	if m.getAllMiniaturePaintsFunc != nil {
@@ -452,9 +573,9 @@ func (m *mockRepository) UpdateMiniaturePaint(ctx context.Context, paint *models
 	return nil
 }
 
-func (m *mockRepository) DeleteMiniaturePaint(ctx context.Context, id int64) error {
+func (m *mockRepository) DeleteMiniaturePaint(ctx context.Context, id int64, opts models.DeleteOptions) error {
 	if m.deleteMiniaturePaintFunc != nil {
-		return m.deleteMiniaturePaintFunc(ctx, id)
+		return m.deleteMiniaturePaintFunc(ctx, id, opts)
 	}
 	return nil
 }
@@ -467,6 +588,436 @@ func (m *mockRepository) DeleteImage(ctx context.Context, id int64) error {
 	return nil
 }
 
+// Dashboard
+func (m *mockRepository) GetDashboard(ctx context.Context, expiringWithinDays int) (*models.Dashboard, error) {
+	if m.getDashboardFunc != nil {
+		return m.getDashboardFunc(ctx, expiringWithinDays)
+	}
+	return &models.Dashboard{}, nil
+}
+
+// Webhooks
+func (m *mockRepository) GetAllWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	if m.getAllWebhooksFunc != nil {
+		return m.getAllWebhooksFunc(ctx)
+	}
+	return []models.Webhook{}, nil
+}
+
+func (m *mockRepository) GetWebhookByID(ctx context.Context, id int64) (*models.Webhook, error) {
+	if m.getWebhookByIDFunc != nil {
+		return m.getWebhookByIDFunc(ctx, id)
+	}
+	return &models.Webhook{ID: id}, nil
+}
+
+func (m *mockRepository) GetActiveWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	if m.getActiveWebhooksFunc != nil {
+		return m.getActiveWebhooksFunc(ctx)
+	}
+	return []models.Webhook{}, nil
+}
+
+func (m *mockRepository) CreateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	if m.createWebhookFunc != nil {
+		return m.createWebhookFunc(ctx, webhook)
+	}
+	return nil
+}
+
+func (m *mockRepository) UpdateWebhook(ctx context.Context, webhook *models.Webhook) error {
+	if m.updateWebhookFunc != nil {
+		return m.updateWebhookFunc(ctx, webhook)
+	}
+	return nil
+}
+
+func (m *mockRepository) DeleteWebhook(ctx context.Context, id int64) error {
+	if m.deleteWebhookFunc != nil {
+		return m.deleteWebhookFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockRepository) GetWebhookDeliveries(ctx context.Context, webhookID int64, limit int) ([]models.WebhookDelivery, error) {
+	if m.getWebhookDeliveriesFunc != nil {
+		return m.getWebhookDeliveriesFunc(ctx, webhookID, limit)
+	}
+	return []models.WebhookDelivery{}, nil
+}
+
+func (m *mockRepository) GetDueWebhookDeliveries(ctx context.Context, now time.Time, excludeWebhookIDs []int64, limit int) ([]models.WebhookDelivery, error) {
+	if m.getDueWebhookDeliveriesFunc != nil {
+		return m.getDueWebhookDeliveriesFunc(ctx, now, excludeWebhookIDs, limit)
+	}
+	return []models.WebhookDelivery{}, nil
+}
+
+func (m *mockRepository) CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if m.createWebhookDeliveryFunc != nil {
+		return m.createWebhookDeliveryFunc(ctx, delivery)
+	}
+	return nil
+}
+
+func (m *mockRepository) UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if m.updateWebhookDeliveryFunc != nil {
+		return m.updateWebhookDeliveryFunc(ctx, delivery)
+	}
+	return nil
+}
+
+// Outbox
+func (m *mockRepository) GetPendingOutboxEvents(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	if m.getPendingOutboxEventsFunc != nil {
+		return m.getPendingOutboxEventsFunc(ctx, limit)
+	}
+	return []models.OutboxEvent{}, nil
+}
+
+func (m *mockRepository) MarkOutboxEventPublished(ctx context.Context, id int64, publishedAt time.Time) error {
+	if m.markOutboxEventPublishedFunc != nil {
+		return m.markOutboxEventPublishedFunc(ctx, id, publishedAt)
+	}
+	return nil
+}
+
+func (m *mockRepository) RecordOutboxEventFailure(ctx context.Context, id int64, lastError string) error {
+	if m.recordOutboxEventFailureFunc != nil {
+		return m.recordOutboxEventFailureFunc(ctx, id, lastError)
+	}
+	return nil
+}
+
+func (m *mockRepository) DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	if m.deletePublishedOutboxEventsFunc != nil {
+		return m.deletePublishedOutboxEventsFunc(ctx, before)
+	}
+	return 0, nil
+}
+
+// Storage Files
+func (m *mockRepository) GetStorageFileByID(ctx context.Context, id int64) (*models.StorageFile, error) {
+	if m.getStorageFileByIDFunc != nil {
+		return m.getStorageFileByIDFunc(ctx, id)
+	}
+	return &models.StorageFile{ID: id, MimeType: "image/png"}, nil
+}
+
+// Orphaned Files
+func (m *mockRepository) GetOrphanedFiles(ctx context.Context) ([]models.StorageFile, error) {
+	if m.getOrphanedFilesFunc != nil {
+		return m.getOrphanedFilesFunc(ctx)
+	}
+	return []models.StorageFile{}, nil
+}
+
+// Merges
+func (m *mockRepository) MergeSkills(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error) {
+	if m.mergeSkillsFunc != nil {
+		return m.mergeSkillsFunc(ctx, targetID, req)
+	}
+	return &models.MergeResult{TargetID: targetID, SourceIDs: req.SourceIDs, Preview: req.Preview}, nil
+}
+
+func (m *mockRepository) MergeSkillTypes(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error) {
+	if m.mergeSkillTypesFunc != nil {
+		return m.mergeSkillTypesFunc(ctx, targetID, req)
+	}
+	return &models.MergeResult{TargetID: targetID, SourceIDs: req.SourceIDs, Preview: req.Preview}, nil
+}
+
+func (m *mockRepository) MergeMiniaturePaints(ctx context.Context, targetID int64, req models.MergeRequest) (*models.MergeResult, error) {
+	if m.mergeMiniaturePaintsFunc != nil {
+		return m.mergeMiniaturePaintsFunc(ctx, targetID, req)
+	}
+	return &models.MergeResult{TargetID: targetID, SourceIDs: req.SourceIDs, Preview: req.Preview}, nil
+}
+
+// Clones
+func (m *mockRepository) CloneMiniatureProject(ctx context.Context, id int64, opts models.CloneOptions) (*models.MiniatureProject, error) {
+	if m.cloneMiniatureProjectFunc != nil {
+		return m.cloneMiniatureProjectFunc(ctx, id, opts)
+	}
+	return &models.MiniatureProject{ID: 2}, nil
+}
+
+func (m *mockRepository) ClonePortfolioProject(ctx context.Context, id int64, opts models.CloneOptions) (*models.PortfolioProject, error) {
+	if m.clonePortfolioProjectFunc != nil {
+		return m.clonePortfolioProjectFunc(ctx, id, opts)
+	}
+	return &models.PortfolioProject{ID: 2}, nil
+}
+
+// Search
+func (m *mockRepository) Search(ctx context.Context, query models.SearchQuery) ([]models.SearchHit, error) {
+	if m.searchFunc != nil {
+		return m.searchFunc(ctx, query)
+	}
+	return []models.SearchHit{}, nil
+}
+
+// Tags
+func (m *mockRepository) GetAllTags(ctx context.Context) ([]models.Tag, error) {
+	if m.getAllTagsFunc != nil {
+		return m.getAllTagsFunc(ctx)
+	}
+	return []models.Tag{}, nil
+}
+
+func (m *mockRepository) GetTagByID(ctx context.Context, id int64) (*models.Tag, error) {
+	if m.getTagByIDFunc != nil {
+		return m.getTagByIDFunc(ctx, id)
+	}
+	return &models.Tag{ID: id}, nil
+}
+
+func (m *mockRepository) CreateTag(ctx context.Context, tag *models.Tag) error {
+	if m.createTagFunc != nil {
+		return m.createTagFunc(ctx, tag)
+	}
+	return nil
+}
+
+func (m *mockRepository) UpdateTag(ctx context.Context, tag *models.Tag) error {
+	if m.updateTagFunc != nil {
+		return m.updateTagFunc(ctx, tag)
+	}
+	return nil
+}
+
+func (m *mockRepository) DeleteTag(ctx context.Context, id int64) error {
+	if m.deleteTagFunc != nil {
+		return m.deleteTagFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockRepository) GetEntityTags(ctx context.Context, entity string, id int64) ([]models.Tag, error) {
+	if m.getEntityTagsFunc != nil {
+		return m.getEntityTagsFunc(ctx, entity, id)
+	}
+	return []models.Tag{}, nil
+}
+
+func (m *mockRepository) SetEntityTags(ctx context.Context, entity string, id int64, tagIDs []int64) error {
+	if m.setEntityTagsFunc != nil {
+		return m.setEntityTagsFunc(ctx, entity, id, tagIDs)
+	}
+	return nil
+}
+
+// Slugs
+func (m *mockRepository) GetEntitySlugs(ctx context.Context, entity string, id int64) (*models.Slugs, error) {
+	if m.getEntitySlugsFunc != nil {
+		return m.getEntitySlugsFunc(ctx, entity, id)
+	}
+	return &models.Slugs{Previous: []models.Slug{}}, nil
+}
+
+func (m *mockRepository) SetEntitySlug(ctx context.Context, entity string, id int64, value string) error {
+	if m.setEntitySlugFunc != nil {
+		return m.setEntitySlugFunc(ctx, entity, id, value)
+	}
+	return nil
+}
+
+func (m *mockRepository) ResolveSlug(ctx context.Context, entity, value string) (*models.Slug, error) {
+	if m.resolveSlugFunc != nil {
+		return m.resolveSlugFunc(ctx, entity, value)
+	}
+	return &models.Slug{Entity: entity, EntityID: 1, Slug: value, IsCurrent: true}, nil
+}
+
+func (m *mockRepository) BackfillSlugs(ctx context.Context) (int, error) {
+	if m.backfillSlugsFunc != nil {
+		return m.backfillSlugsFunc(ctx)
+	}
+	return 0, nil
+}
+
+// Visibility
+func (m *mockRepository) GetEntityVisibility(ctx context.Context, entity string, id int64) (*models.Visibility, error) {
+	if m.getEntityVisibilityFunc != nil {
+		return m.getEntityVisibilityFunc(ctx, entity, id)
+	}
+	visible := true
+	return &models.Visibility{Visible: &visible}, nil
+}
+
+func (m *mockRepository) SetEntityVisibility(ctx context.Context, entity string, id int64, visible bool) error {
+	if m.setEntityVisibilityFunc != nil {
+		return m.setEntityVisibilityFunc(ctx, entity, id, visible)
+	}
+	return nil
+}
+
+// SEO
+func (m *mockRepository) GetSEO(ctx context.Context, entity string, id int64) (*models.SEO, error) {
+	if m.getSEOFunc != nil {
+		return m.getSEOFunc(ctx, entity, id)
+	}
+	return &models.SEO{Entity: entity, EntityID: id, Issues: []models.SEOIssue{}}, nil
+}
+
+func (m *mockRepository) GetAllSEO(ctx context.Context, entity string) ([]models.SEO, error) {
+	if m.getAllSEOFunc != nil {
+		return m.getAllSEOFunc(ctx, entity)
+	}
+	return []models.SEO{}, nil
+}
+
+func (m *mockRepository) SetSEO(ctx context.Context, meta *models.SEOMetadata) error {
+	if m.setSEOFunc != nil {
+		return m.setSEOFunc(ctx, meta)
+	}
+	return nil
+}
+
+// Translations
+func (m *mockRepository) GetTranslations(ctx context.Context, entity string, id int64) (*models.Translations, error) {
+	if m.getTranslationsFunc != nil {
+		return m.getTranslationsFunc(ctx, entity, id)
+	}
+	return &models.Translations{Entity: entity, EntityID: id, Locales: map[string]map[string]interface{}{}}, nil
+}
+
+func (m *mockRepository) GetTranslationValues(ctx context.Context, entity, locale string, ids []int64) (map[int64]map[string]string, error) {
+	if m.getTranslationValuesFunc != nil {
+		return m.getTranslationValuesFunc(ctx, entity, locale, ids)
+	}
+	return map[int64]map[string]string{}, nil
+}
+
+func (m *mockRepository) SetTranslations(ctx context.Context, entity string, id int64, locale string, values map[string]string) error {
+	if m.setTranslationsFunc != nil {
+		return m.setTranslationsFunc(ctx, entity, id, locale, values)
+	}
+	return nil
+}
+
+func (m *mockRepository) GetMissingTranslations(ctx context.Context, entity string, locales []string) ([]models.MissingTranslation, error) {
+	if m.getMissingTranslationsFunc != nil {
+		return m.getMissingTranslationsFunc(ctx, entity, locales)
+	}
+	return []models.MissingTranslation{}, nil
+}
+
+// Education
+func (m *mockRepository) GetAllEducation(ctx context.Context) ([]models.Education, error) {
+	if m.getAllEducationFunc != nil {
+		return m.getAllEducationFunc(ctx)
+	}
+	return []models.Education{}, nil
+}
+
+func (m *mockRepository) GetEducationByID(ctx context.Context, id int64) (*models.Education, error) {
+	if m.getEducationByIDFunc != nil {
+		return m.getEducationByIDFunc(ctx, id)
+	}
+	return &models.Education{ID: id}, nil
+}
+
+func (m *mockRepository) CreateEducation(ctx context.Context, education *models.Education) error {
+	if m.createEducationFunc != nil {
+		return m.createEducationFunc(ctx, education)
+	}
+	return nil
+}
+
+func (m *mockRepository) UpdateEducation(ctx context.Context, education *models.Education) error {
+	if m.updateEducationFunc != nil {
+		return m.updateEducationFunc(ctx, education)
+	}
+	return nil
+}
+
+func (m *mockRepository) DeleteEducation(ctx context.Context, id int64) error {
+	if m.deleteEducationFunc != nil {
+		return m.deleteEducationFunc(ctx, id)
+	}
+	return nil
+}
+
+// Testimonials
+func (m *mockRepository) GetAllTestimonials(ctx context.Context, filter models.TestimonialFilter) ([]models.Testimonial, error) {
+	if m.getAllTestimonialsFunc != nil {
+		return m.getAllTestimonialsFunc(ctx, filter)
+	}
+	return []models.Testimonial{}, nil
+}
+
+func (m *mockRepository) GetTestimonialByID(ctx context.Context, id int64) (*models.Testimonial, error) {
+	if m.getTestimonialByIDFunc != nil {
+		return m.getTestimonialByIDFunc(ctx, id)
+	}
+	return &models.Testimonial{ID: id}, nil
+}
+
+func (m *mockRepository) CreateTestimonial(ctx context.Context, testimonial *models.Testimonial) error {
+	if m.createTestimonialFunc != nil {
+		return m.createTestimonialFunc(ctx, testimonial)
+	}
+	return nil
+}
+
+func (m *mockRepository) UpdateTestimonial(ctx context.Context, testimonial *models.Testimonial) error {
+	if m.updateTestimonialFunc != nil {
+		return m.updateTestimonialFunc(ctx, testimonial)
+	}
+	return nil
+}
+
+func (m *mockRepository) DeleteTestimonial(ctx context.Context, id int64) error {
+	if m.deleteTestimonialFunc != nil {
+		return m.deleteTestimonialFunc(ctx, id)
+	}
+	return nil
+}
+
+// Articles
+func (m *mockRepository) GetAllArticles(ctx context.Context, filter models.ArticleFilter) ([]models.Article, error) {
+	if m.getAllArticlesFunc != nil {
+		return m.getAllArticlesFunc(ctx, filter)
+	}
+	return []models.Article{}, nil
+}
+
+func (m *mockRepository) GetArticleByID(ctx context.Context, id int64) (*models.Article, error) {
+	if m.getArticleByIDFunc != nil {
+		return m.getArticleByIDFunc(ctx, id)
+	}
+	return &models.Article{ID: id}, nil
+}
+
+func (m *mockRepository) CreateArticle(ctx context.Context, article *models.Article, skillIDs []int64) error {
+	if m.createArticleFunc != nil {
+		return m.createArticleFunc(ctx, article, skillIDs)
+	}
+	return nil
+}
+
+func (m *mockRepository) UpdateArticle(ctx context.Context, article *models.Article, skillIDs []int64) error {
+	if m.updateArticleFunc != nil {
+		return m.updateArticleFunc(ctx, article, skillIDs)
+	}
+	return nil
+}
+
+func (m *mockRepository) DeleteArticle(ctx context.Context, id int64) error {
+	if m.deleteArticleFunc != nil {
+		return m.deleteArticleFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *mockRepository) SetArticleSkills(ctx context.Context, articleID int64, skillIDs []int64) error {
+	if m.setArticleSkillsFunc != nil {
+		return m.setArticleSkillsFunc(ctx, articleID, skillIDs)
+	}
+	return nil
+}
+
 // =============================================================================
 // Test Helpers
 // =============================================================================
@@ -497,15 +1048,23 @@ func setupRouterWithScopes(t *testing.T, scopes map[string]string) *gin.Engine {
 			portfolio.GET("/profile", common.RequirePermission(common.ResourceProfile, common.LevelRead), handler.GetProfile)
 			portfolio.PUT("/profile", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UpdateProfile)
 			portfolio.PUT("/profile/avatar", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UpdateProfileAvatar)
+			portfolio.POST("/profile/avatar/upload", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UploadProfileAvatar)
 			portfolio.DELETE("/profile/avatar", common.RequirePermission(common.ResourceProfile, common.LevelDelete), handler.DeleteProfileAvatar)
 			portfolio.PUT("/profile/resume", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UpdateProfileResume)
+			portfolio.POST("/profile/resume/upload", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.UploadProfileResume)
 			portfolio.DELETE("/profile/resume", common.RequirePermission(common.ResourceProfile, common.LevelDelete), handler.DeleteProfileResume)
+			portfolio.GET("/profile/seo", common.RequirePermission(common.ResourceProfile, common.LevelRead), handler.GetProfileSEO)
+			portfolio.PUT("/profile/seo", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.SetProfileSEO)
+			portfolio.GET("/profile/translations", common.RequirePermission(common.ResourceProfile, common.LevelRead), handler.GetProfileTranslations)
+			portfolio.PUT("/profile/translations/:locale", common.RequirePermission(common.ResourceProfile, common.LevelEdit), handler.SetProfileTranslations)
 
 			portfolio.GET("/experience", common.RequirePermission(common.ResourceExperience, common.LevelRead), handler.GetAllWorkExperience)
 			portfolio.POST("/experience", common.RequirePermission(common.ResourceExperience, common.LevelEdit), handler.CreateWorkExperience)
 			portfolio.GET("/experience/:id", common.RequirePermission(common.ResourceExperience, common.LevelRead), handler.GetWorkExperienceByID)
 			portfolio.PUT("/experience/:id", common.RequirePermission(common.ResourceExperience, common.LevelEdit), handler.UpdateWorkExperience)
 			portfolio.DELETE("/experience/:id", common.RequirePermission(common.ResourceExperience, common.LevelDelete), handler.DeleteWorkExperience)
+			portfolio.GET("/experience/:id/translations", common.RequirePermission(common.ResourceExperience, common.LevelRead), handler.GetWorkExperienceTranslations)
+			portfolio.PUT("/experience/:id/translations/:locale", common.RequirePermission(common.ResourceExperience, common.LevelEdit), handler.SetWorkExperienceTranslations)
 
 			portfolio.GET("/certifications", common.RequirePermission(common.ResourceCertifications, common.LevelRead), handler.GetAllCertifications)
 			portfolio.POST("/certifications", common.RequirePermission(common.ResourceCertifications, common.LevelEdit), handler.CreateCertification)
@@ -513,23 +1072,51 @@ func setupRouterWithScopes(t *testing.T, scopes map[string]string) *gin.Engine {
 			portfolio.PUT("/certifications/:id", common.RequirePermission(common.ResourceCertifications, common.LevelEdit), handler.UpdateCertification)
 			portfolio.DELETE("/certifications/:id", common.RequirePermission(common.ResourceCertifications, common.LevelDelete), handler.DeleteCertification)
 
+			// Education
+			portfolio.GET("/education", common.RequirePermission(models.ResourceEducation, common.LevelRead), handler.GetAllEducation)
+			portfolio.POST("/education", common.RequirePermission(models.ResourceEducation, common.LevelEdit), handler.CreateEducation)
+			portfolio.GET("/education/:id", common.RequirePermission(models.ResourceEducation, common.LevelRead), handler.GetEducationByID)
+			portfolio.PUT("/education/:id", common.RequirePermission(models.ResourceEducation, common.LevelEdit), handler.UpdateEducation)
+			portfolio.DELETE("/education/:id", common.RequirePermission(models.ResourceEducation, common.LevelDelete), handler.DeleteEducation)
+
+			// Testimonials
+			portfolio.GET("/testimonials", common.RequirePermission(models.ResourceTestimonials, common.LevelRead), handler.GetAllTestimonials)
+			portfolio.POST("/testimonials", common.RequirePermission(models.ResourceTestimonials, common.LevelEdit), handler.CreateTestimonial)
+			portfolio.GET("/testimonials/:id", common.RequirePermission(models.ResourceTestimonials, common.LevelRead), handler.GetTestimonialByID)
+			portfolio.PUT("/testimonials/:id", common.RequirePermission(models.ResourceTestimonials, common.LevelEdit), handler.UpdateTestimonial)
+			portfolio.DELETE("/testimonials/:id", common.RequirePermission(models.ResourceTestimonials, common.LevelDelete), handler.DeleteTestimonial)
+
 			portfolio.GET("/skills", common.RequirePermission(common.ResourceSkills, common.LevelRead), handler.GetAllSkills)
 			portfolio.POST("/skills", common.RequirePermission(common.ResourceSkills, common.LevelEdit), handler.CreateSkill)
 			portfolio.GET("/skills/:id", common.RequirePermission(common.ResourceSkills, common.LevelRead), handler.GetSkillByID)
 			portfolio.PUT("/skills/:id", common.RequirePermission(common.ResourceSkills, common.LevelEdit), handler.UpdateSkill)
 			portfolio.DELETE("/skills/:id", common.RequirePermission(common.ResourceSkills, common.LevelDelete), handler.DeleteSkill)
+			portfolio.POST("/skills/:id/merge", common.RequirePermission(common.ResourceSkills, common.LevelDelete), handler.MergeSkills)
 
 			portfolio.GET("/skill-types", common.RequirePermission(common.ResourceSkills, common.LevelRead), handler.GetAllSkillTypes)
 			portfolio.POST("/skill-types", common.RequirePermission(common.ResourceSkills, common.LevelEdit), handler.CreateSkillType)
 			portfolio.GET("/skill-types/:id", common.RequirePermission(common.ResourceSkills, common.LevelRead), handler.GetSkillTypeByID)
 			portfolio.PUT("/skill-types/:id", common.RequirePermission(common.ResourceSkills, common.LevelEdit), handler.UpdateSkillType)
 			portfolio.DELETE("/skill-types/:id", common.RequirePermission(common.ResourceSkills, common.LevelDelete), handler.DeleteSkillType)
+			portfolio.POST("/skill-types/:id/merge", common.RequirePermission(common.ResourceSkills, common.LevelDelete), handler.MergeSkillTypes)
 
 			portfolio.GET("/projects", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetAllPortfolioProjects)
 			portfolio.POST("/projects", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.CreatePortfolioProject)
 			portfolio.GET("/projects/:id", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectByID)
+			portfolio.GET("/projects/slug/:slug", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectBySlug)
 			portfolio.PUT("/projects/:id", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.UpdatePortfolioProject)
 			portfolio.DELETE("/projects/:id", common.RequirePermission(common.ResourceProjects, common.LevelDelete), handler.DeletePortfolioProject)
+			portfolio.POST("/projects/:id/clone", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.ClonePortfolioProject)
+			portfolio.GET("/projects/:id/tags", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectTags)
+			portfolio.PUT("/projects/:id/tags", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectTags)
+			portfolio.GET("/projects/:id/slugs", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectSlugs)
+			portfolio.PUT("/projects/:id/slug", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectSlug)
+			portfolio.GET("/projects/:id/visibility", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectVisibility)
+			portfolio.PUT("/projects/:id/visibility", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectVisibility)
+			portfolio.GET("/projects/:id/seo", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectSEO)
+			portfolio.PUT("/projects/:id/seo", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectSEO)
+			portfolio.GET("/projects/:id/translations", common.RequirePermission(common.ResourceProjects, common.LevelRead), handler.GetPortfolioProjectTranslations)
+			portfolio.PUT("/projects/:id/translations/:locale", common.RequirePermission(common.ResourceProjects, common.LevelEdit), handler.SetPortfolioProjectTranslations)
 		}
 
 		// Miniatures domain
@@ -538,29 +1125,116 @@ func setupRouterWithScopes(t *testing.T, scopes map[string]string) *gin.Engine {
 			miniatures.GET("/themes", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetAllMiniatureThemes)
 			miniatures.POST("/themes", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.CreateMiniatureTheme)
 			miniatures.GET("/themes/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeByID)
+			miniatures.GET("/themes/slug/:slug", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeBySlug)
 			miniatures.PUT("/themes/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.UpdateMiniatureTheme)
 			miniatures.DELETE("/themes/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelDelete), handler.DeleteMiniatureTheme)
+			miniatures.GET("/themes/:id/tags", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeTags)
+			miniatures.PUT("/themes/:id/tags", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureThemeTags)
+			miniatures.GET("/themes/:id/slugs", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeSlugs)
+			miniatures.PUT("/themes/:id/slug", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureThemeSlug)
+			miniatures.GET("/themes/:id/seo", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureThemeSEO)
+			miniatures.PUT("/themes/:id/seo", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureThemeSEO)
 
 			miniatures.GET("/projects", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetAllMiniatureProjects)
 			miniatures.POST("/projects", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.CreateMiniatureProject)
 			miniatures.GET("/projects/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectByID)
+			miniatures.GET("/projects/slug/:slug", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectBySlug)
 			miniatures.PUT("/projects/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.UpdateMiniatureProject)
 			miniatures.DELETE("/projects/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelDelete), handler.DeleteMiniatureProject)
+			miniatures.POST("/projects/:id/clone", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.CloneMiniatureProject)
 			miniatures.POST("/projects/:id/images", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.AddImageToProject)
+			miniatures.POST("/projects/:id/images/upload", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.UploadProjectImage)
 			miniatures.PUT("/projects/:id/techniques", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetProjectTechniques)
 			miniatures.PUT("/projects/:id/paints", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetProjectPaints)
+			miniatures.GET("/projects/:id/tags", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectTags)
+			miniatures.PUT("/projects/:id/tags", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectTags)
+			miniatures.GET("/projects/:id/slugs", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectSlugs)
+			miniatures.PUT("/projects/:id/slug", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectSlug)
+			miniatures.GET("/projects/:id/visibility", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectVisibility)
+			miniatures.PUT("/projects/:id/visibility", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectVisibility)
+			miniatures.GET("/projects/:id/seo", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectSEO)
+			miniatures.PUT("/projects/:id/seo", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectSEO)
+			miniatures.GET("/projects/:id/translations", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureProjectTranslations)
+			miniatures.PUT("/projects/:id/translations/:locale", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.SetMiniatureProjectTranslations)
 
 			miniatures.GET("/techniques", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetAllTechniques)
 
+			miniatures.GET("/stats", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniatureStats)
+
 			miniatures.GET("/paints", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetAllMiniaturePaints)
 			miniatures.POST("/paints", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.CreateMiniaturePaint)
 			miniatures.GET("/paints/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelRead), handler.GetMiniaturePaintByID)
 			miniatures.PUT("/paints/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelEdit), handler.UpdateMiniaturePaint)
 			miniatures.DELETE("/paints/:id", common.RequirePermission(common.ResourceMiniatures, common.LevelDelete), handler.DeleteMiniaturePaint)
+			miniatures.POST("/paints/:id/merge", common.RequirePermission(common.ResourceMiniatures, common.LevelDelete), handler.MergeMiniaturePaints)
+		}
+
+		// Blog domain
+		blog := v1.Group("/blog")
+		{
+			// Articles
+			blog.GET("/articles", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetAllArticles)
+			blog.POST("/articles", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.CreateArticle)
+			blog.GET("/articles/:id", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleByID)
+			blog.GET("/articles/slug/:slug", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleBySlug)
+			blog.PUT("/articles/:id", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.UpdateArticle)
+			blog.DELETE("/articles/:id", common.RequirePermission(models.ResourceBlog, common.LevelDelete), handler.DeleteArticle)
+			blog.PUT("/articles/:id/skills", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.SetArticleSkills)
+			blog.GET("/articles/:id/tags", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleTags)
+			blog.PUT("/articles/:id/tags", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.SetArticleTags)
+			blog.GET("/articles/:id/slugs", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleSlugs)
+			blog.PUT("/articles/:id/slug", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.SetArticleSlug)
+			blog.GET("/articles/:id/seo", common.RequirePermission(models.ResourceBlog, common.LevelRead), handler.GetArticleSEO)
+			blog.PUT("/articles/:id/seo", common.RequirePermission(models.ResourceBlog, common.LevelEdit), handler.SetArticleSEO)
 		}
 
+		// Tags
+		tags := v1.Group("/tags")
+		{
+			tags.GET("", requireAnyPermission(common.LevelRead, models.TagResources...), handler.GetAllTags)
+			tags.POST("", requireAnyPermission(common.LevelEdit, models.TagResources...), handler.CreateTag)
+			tags.GET("/:id", requireAnyPermission(common.LevelRead, models.TagResources...), handler.GetTagByID)
+			tags.PUT("/:id", requireAnyPermission(common.LevelEdit, models.TagResources...), handler.UpdateTag)
+			tags.DELETE("/:id", requireAnyPermission(common.LevelDelete, models.TagResources...), handler.DeleteTag)
+		}
+
+		// Dashboard
+		v1.GET("/dashboard", handler.GetDashboard)
+
+		// Full-text search (filtered per resource by the caller's read permissions)
+		v1.GET("/search", handler.Search)
+
+		// SEO
+		v1.GET("/seo/check", handler.CheckSEO)
+
+		// Translations
+		v1.GET("/translations/missing", handler.GetMissingTranslations)
+
+		// Markdown
+		v1.POST("/render/markdown", handler.RenderMarkdown)
+
+		// Events
+		v1.GET("/events/stream", handler.StreamEvents)
+
+		// Webhooks
+		webhooks := v1.Group("/webhooks")
+		{
+			webhooks.GET("", common.RequirePermission(models.ResourceWebhooks, common.LevelRead), handler.GetAllWebhooks)
+			webhooks.POST("", common.RequirePermission(models.ResourceWebhooks, common.LevelEdit), handler.CreateWebhook)
+			webhooks.GET("/:id", common.RequirePermission(models.ResourceWebhooks, common.LevelRead), handler.GetWebhookByID)
+			webhooks.PUT("/:id", common.RequirePermission(models.ResourceWebhooks, common.LevelEdit), handler.UpdateWebhook)
+			webhooks.DELETE("/:id", common.RequirePermission(models.ResourceWebhooks, common.LevelDelete), handler.DeleteWebhook)
+			webhooks.GET("/:id/deliveries", common.RequirePermission(models.ResourceWebhooks, common.LevelRead), handler.GetWebhookDeliveries)
+			webhooks.POST("/:id/test", common.RequirePermission(models.ResourceWebhooks, common.LevelEdit), handler.SendWebhookTestEvent)
+		}
+
+		// Cache
+		v1.POST("/cache/purge", common.RequirePermission(models.ResourceCache, common.LevelEdit), handler.PurgeCache)
+
 		// Files
 		v1.DELETE("/files/:id", common.RequirePermission(common.ResourceFiles, common.LevelDelete), handler.DeleteImage)
+		v1.GET("/files/orphans", common.RequirePermission(common.ResourceFiles, common.LevelRead), handler.GetOrphanedFiles)
+		v1.POST("/files/orphans/purge", common.RequirePermission(common.ResourceFiles, common.LevelDelete), handler.PurgeOrphanedFiles)
 	}
 
 	return router
@@ -593,39 +1267,71 @@ var portfolioRoutes = []routePermission{
 	{"GET", "/api/v1/portfolio/profile", common.ResourceProfile, common.LevelRead},
 	{"PUT", "/api/v1/portfolio/profile", common.ResourceProfile, common.LevelEdit},
 	{"PUT", "/api/v1/portfolio/profile/avatar", common.ResourceProfile, common.LevelEdit},
+	{"POST", "/api/v1/portfolio/profile/avatar/upload", common.ResourceProfile, common.LevelEdit},
 	{"DELETE", "/api/v1/portfolio/profile/avatar", common.ResourceProfile, common.LevelDelete},
 	{"PUT", "/api/v1/portfolio/profile/resume", common.ResourceProfile, common.LevelEdit},
+	{"POST", "/api/v1/portfolio/profile/resume/upload", common.ResourceProfile, common.LevelEdit},
 	{"DELETE", "/api/v1/portfolio/profile/resume", common.ResourceProfile, common.LevelDelete},
+	{"GET", "/api/v1/portfolio/profile/seo", common.ResourceProfile, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/profile/seo", common.ResourceProfile, common.LevelEdit},
+	{"GET", "/api/v1/portfolio/profile/translations", common.ResourceProfile, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/profile/translations/lv", common.ResourceProfile, common.LevelEdit},
 	// Work Experience
 	{"GET", "/api/v1/portfolio/experience", common.ResourceExperience, common.LevelRead},
 	{"POST", "/api/v1/portfolio/experience", common.ResourceExperience, common.LevelEdit},
 	{"GET", "/api/v1/portfolio/experience/1", common.ResourceExperience, common.LevelRead},
 	{"PUT", "/api/v1/portfolio/experience/1", common.ResourceExperience, common.LevelEdit},
 	{"DELETE", "/api/v1/portfolio/experience/1", common.ResourceExperience, common.LevelDelete},
+	{"GET", "/api/v1/portfolio/experience/1/translations", common.ResourceExperience, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/experience/1/translations/lv", common.ResourceExperience, common.LevelEdit},
 	// Certifications
 	{"GET", "/api/v1/portfolio/certifications", common.ResourceCertifications, common.LevelRead},
 	{"POST", "/api/v1/portfolio/certifications", common.ResourceCertifications, common.LevelEdit},
 	{"GET", "/api/v1/portfolio/certifications/1", common.ResourceCertifications, common.LevelRead},
 	{"PUT", "/api/v1/portfolio/certifications/1", common.ResourceCertifications, common.LevelEdit},
 	{"DELETE", "/api/v1/portfolio/certifications/1", common.ResourceCertifications, common.LevelDelete},
+	{"GET", "/api/v1/portfolio/education", models.ResourceEducation, common.LevelRead},
+	{"POST", "/api/v1/portfolio/education", models.ResourceEducation, common.LevelEdit},
+	{"GET", "/api/v1/portfolio/education/1", models.ResourceEducation, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/education/1", models.ResourceEducation, common.LevelEdit},
+	{"DELETE", "/api/v1/portfolio/education/1", models.ResourceEducation, common.LevelDelete},
+	{"GET", "/api/v1/portfolio/testimonials", models.ResourceTestimonials, common.LevelRead},
+	{"POST", "/api/v1/portfolio/testimonials", models.ResourceTestimonials, common.LevelEdit},
+	{"GET", "/api/v1/portfolio/testimonials/1", models.ResourceTestimonials, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/testimonials/1", models.ResourceTestimonials, common.LevelEdit},
+	{"DELETE", "/api/v1/portfolio/testimonials/1", models.ResourceTestimonials, common.LevelDelete},
 	// Skills
 	{"GET", "/api/v1/portfolio/skills", common.ResourceSkills, common.LevelRead},
 	{"POST", "/api/v1/portfolio/skills", common.ResourceSkills, common.LevelEdit},
 	{"GET", "/api/v1/portfolio/skills/1", common.ResourceSkills, common.LevelRead},
 	{"PUT", "/api/v1/portfolio/skills/1", common.ResourceSkills, common.LevelEdit},
 	{"DELETE", "/api/v1/portfolio/skills/1", common.ResourceSkills, common.LevelDelete},
+	{"POST", "/api/v1/portfolio/skills/1/merge", common.ResourceSkills, common.LevelDelete},
 	// Skill Types
 	{"GET", "/api/v1/portfolio/skill-types", common.ResourceSkills, common.LevelRead},
 	{"POST", "/api/v1/portfolio/skill-types", common.ResourceSkills, common.LevelEdit},
 	{"GET", "/api/v1/portfolio/skill-types/1", common.ResourceSkills, common.LevelRead},
 	{"PUT", "/api/v1/portfolio/skill-types/1", common.ResourceSkills, common.LevelEdit},
 	{"DELETE", "/api/v1/portfolio/skill-types/1", common.ResourceSkills, common.LevelDelete},
+	{"POST", "/api/v1/portfolio/skill-types/1/merge", common.ResourceSkills, common.LevelDelete},
 	// Portfolio Projects
 	{"GET", "/api/v1/portfolio/projects", common.ResourceProjects, common.LevelRead},
 	{"POST", "/api/v1/portfolio/projects", common.ResourceProjects, common.LevelEdit},
 	{"GET", "/api/v1/portfolio/projects/1", common.ResourceProjects, common.LevelRead},
+	{"GET", "/api/v1/portfolio/projects/slug/order-pipeline", common.ResourceProjects, common.LevelRead},
 	{"PUT", "/api/v1/portfolio/projects/1", common.ResourceProjects, common.LevelEdit},
 	{"DELETE", "/api/v1/portfolio/projects/1", common.ResourceProjects, common.LevelDelete},
+	{"POST", "/api/v1/portfolio/projects/1/clone", common.ResourceProjects, common.LevelEdit},
+	{"GET", "/api/v1/portfolio/projects/1/tags", common.ResourceProjects, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/projects/1/tags", common.ResourceProjects, common.LevelEdit},
+	{"GET", "/api/v1/portfolio/projects/1/slugs", common.ResourceProjects, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/projects/1/slug", common.ResourceProjects, common.LevelEdit},
+	{"GET", "/api/v1/portfolio/projects/1/visibility", common.ResourceProjects, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/projects/1/visibility", common.ResourceProjects, common.LevelEdit},
+	{"GET", "/api/v1/portfolio/projects/1/seo", common.ResourceProjects, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/projects/1/seo", common.ResourceProjects, common.LevelEdit},
+	{"GET", "/api/v1/portfolio/projects/1/translations", common.ResourceProjects, common.LevelRead},
+	{"PUT", "/api/v1/portfolio/projects/1/translations/lv", common.ResourceProjects, common.LevelEdit},
 }
 
 var miniaturesRoutes = []routePermission{
@@ -633,29 +1339,94 @@ var miniaturesRoutes = []routePermission{
 	{"GET", "/api/v1/miniatures/themes", common.ResourceMiniatures, common.LevelRead},
 	{"POST", "/api/v1/miniatures/themes", common.ResourceMiniatures, common.LevelEdit},
 	{"GET", "/api/v1/miniatures/themes/1", common.ResourceMiniatures, common.LevelRead},
+	{"GET", "/api/v1/miniatures/themes/slug/grimdark", common.ResourceMiniatures, common.LevelRead},
 	{"PUT", "/api/v1/miniatures/themes/1", common.ResourceMiniatures, common.LevelEdit},
 	{"DELETE", "/api/v1/miniatures/themes/1", common.ResourceMiniatures, common.LevelDelete},
+	{"GET", "/api/v1/miniatures/themes/1/tags", common.ResourceMiniatures, common.LevelRead},
+	{"PUT", "/api/v1/miniatures/themes/1/tags", common.ResourceMiniatures, common.LevelEdit},
+	{"GET", "/api/v1/miniatures/themes/1/slugs", common.ResourceMiniatures, common.LevelRead},
+	{"PUT", "/api/v1/miniatures/themes/1/slug", common.ResourceMiniatures, common.LevelEdit},
+	{"GET", "/api/v1/miniatures/themes/1/seo", common.ResourceMiniatures, common.LevelRead},
+	{"PUT", "/api/v1/miniatures/themes/1/seo", common.ResourceMiniatures, common.LevelEdit},
 	// Projects
 	{"GET", "/api/v1/miniatures/projects", common.ResourceMiniatures, common.LevelRead},
 	{"POST", "/api/v1/miniatures/projects", common.ResourceMiniatures, common.LevelEdit},
 	{"GET", "/api/v1/miniatures/projects/1", common.ResourceMiniatures, common.LevelRead},
+	{"GET", "/api/v1/miniatures/projects/slug/space-marine", common.ResourceMiniatures, common.LevelRead},
 	{"PUT", "/api/v1/miniatures/projects/1", common.ResourceMiniatures, common.LevelEdit},
 	{"DELETE", "/api/v1/miniatures/projects/1", common.ResourceMiniatures, common.LevelDelete},
+	{"POST", "/api/v1/miniatures/projects/1/clone", common.ResourceMiniatures, common.LevelEdit},
 	{"POST", "/api/v1/miniatures/projects/1/images", common.ResourceMiniatures, common.LevelEdit},
+	{"POST", "/api/v1/miniatures/projects/1/images/upload", common.ResourceMiniatures, common.LevelEdit},
 	{"PUT", "/api/v1/miniatures/projects/1/techniques", common.ResourceMiniatures, common.LevelEdit},
 	{"PUT", "/api/v1/miniatures/projects/1/paints", common.ResourceMiniatures, common.LevelEdit},
+	{"GET", "/api/v1/miniatures/projects/1/tags", common.ResourceMiniatures, common.LevelRead},
+	{"PUT", "/api/v1/miniatures/projects/1/tags", common.ResourceMiniatures, common.LevelEdit},
+	{"GET", "/api/v1/miniatures/projects/1/slugs", common.ResourceMiniatures, common.LevelRead},
+	{"PUT", "/api/v1/miniatures/projects/1/slug", common.ResourceMiniatures, common.LevelEdit},
+	{"GET", "/api/v1/miniatures/projects/1/visibility", common.ResourceMiniatures, common.LevelRead},
+	{"PUT", "/api/v1/miniatures/projects/1/visibility", common.ResourceMiniatures, common.LevelEdit},
+	{"GET", "/api/v1/miniatures/projects/1/seo", common.ResourceMiniatures, common.LevelRead},
+	{"PUT", "/api/v1/miniatures/projects/1/seo", common.ResourceMiniatures, common.LevelEdit},
+	{"GET", "/api/v1/miniatures/projects/1/translations", common.ResourceMiniatures, common.LevelRead},
+	{"PUT", "/api/v1/miniatures/projects/1/translations/lv", common.ResourceMiniatures, common.LevelEdit},
 	// Techniques
 	{"GET", "/api/v1/miniatures/techniques", common.ResourceMiniatures, common.LevelRead},
+	// Stats
+	{"GET", "/api/v1/miniatures/stats", common.ResourceMiniatures, common.LevelRead},
 	// Paints
 	{"GET", "/api/v1/miniatures/paints", common.ResourceMiniatures, common.LevelRead},
 	{"POST", "/api/v1/miniatures/paints", common.ResourceMiniatures, common.LevelEdit},
 	{"GET", "/api/v1/miniatures/paints/1", common.ResourceMiniatures, common.LevelRead},
 	{"PUT", "/api/v1/miniatures/paints/1", common.ResourceMiniatures, common.LevelEdit},
 	{"DELETE", "/api/v1/miniatures/paints/1", common.ResourceMiniatures, common.LevelDelete},
+	{"POST", "/api/v1/miniatures/paints/1/merge", common.ResourceMiniatures, common.LevelDelete},
+}
+
+var blogRoutes = []routePermission{
+	// Articles
+	{"GET", "/api/v1/blog/articles", models.ResourceBlog, common.LevelRead},
+	{"POST", "/api/v1/blog/articles", models.ResourceBlog, common.LevelEdit},
+	{"GET", "/api/v1/blog/articles/1", models.ResourceBlog, common.LevelRead},
+	{"GET", "/api/v1/blog/articles/slug/my-article", models.ResourceBlog, common.LevelRead},
+	{"PUT", "/api/v1/blog/articles/1", models.ResourceBlog, common.LevelEdit},
+	{"DELETE", "/api/v1/blog/articles/1", models.ResourceBlog, common.LevelDelete},
+	{"PUT", "/api/v1/blog/articles/1/skills", models.ResourceBlog, common.LevelEdit},
+	{"GET", "/api/v1/blog/articles/1/tags", models.ResourceBlog, common.LevelRead},
+	{"PUT", "/api/v1/blog/articles/1/tags", models.ResourceBlog, common.LevelEdit},
+	{"GET", "/api/v1/blog/articles/1/slugs", models.ResourceBlog, common.LevelRead},
+	{"PUT", "/api/v1/blog/articles/1/slug", models.ResourceBlog, common.LevelEdit},
+	{"GET", "/api/v1/blog/articles/1/seo", models.ResourceBlog, common.LevelRead},
+	{"PUT", "/api/v1/blog/articles/1/seo", models.ResourceBlog, common.LevelEdit},
 }
 
 var filesRoutes = []routePermission{
 	{"DELETE", "/api/v1/files/1", common.ResourceFiles, common.LevelDelete},
+	{"GET", "/api/v1/files/orphans", common.ResourceFiles, common.LevelRead},
+	{"POST", "/api/v1/files/orphans/purge", common.ResourceFiles, common.LevelDelete},
+}
+
+var webhooksRoutes = []routePermission{
+	{"GET", "/api/v1/webhooks", models.ResourceWebhooks, common.LevelRead},
+	{"POST", "/api/v1/webhooks", models.ResourceWebhooks, common.LevelEdit},
+	{"GET", "/api/v1/webhooks/1", models.ResourceWebhooks, common.LevelRead},
+	{"PUT", "/api/v1/webhooks/1", models.ResourceWebhooks, common.LevelEdit},
+	{"DELETE", "/api/v1/webhooks/1", models.ResourceWebhooks, common.LevelDelete},
+	{"GET", "/api/v1/webhooks/1/deliveries", models.ResourceWebhooks, common.LevelRead},
+	{"POST", "/api/v1/webhooks/1/test", models.ResourceWebhooks, common.LevelEdit},
+}
+
+var cacheRoutes = []routePermission{
+	{"POST", "/api/v1/cache/purge", models.ResourceCache, common.LevelEdit},
+}
+
+// tagsRoutes are granted by any of models.TagResources; resource is left empty
+var tagsRoutes = []routePermission{
+	{"GET", "/api/v1/tags", "", common.LevelRead},
+	{"POST", "/api/v1/tags", "", common.LevelEdit},
+	{"GET", "/api/v1/tags/1", "", common.LevelRead},
+	{"PUT", "/api/v1/tags/1", "", common.LevelEdit},
+	{"DELETE", "/api/v1/tags/1", "", common.LevelDelete},
 }
 
 // =============================================================================
@@ -741,6 +1512,39 @@ func TestMiniaturesRoutes_Allowed_WithPermission(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Blog Route Permission Tests
+// =============================================================================
+
+func TestBlogRoutes_Forbidden_WithoutPermission(t *testing.T) {
+	for _, route := range blogRoutes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			router := setupRouterWithScopes(t, map[string]string{})
+			w := performRequest(t, router, route.method, route.path)
+
+			if w.Code != http.StatusForbidden {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+			}
+		})
+	}
+}
+
+func TestBlogRoutes_Allowed_WithPermission(t *testing.T) {
+	for _, route := range blogRoutes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			scopes := map[string]string{route.resource: route.level}
+			router := setupRouterWithScopes(t, scopes)
+			w := performRequest(t, router, route.method, route.path)
+
+			// We only verify authorization passes (not 403/401).
+			// Handler may return 400/404/500 due to missing body or mock defaults.
+			if w.Code == http.StatusForbidden {
+				t.Errorf("got 403 Forbidden with permission %s:%s", route.resource, route.level)
+			}
+		})
+	}
+}
+
 // =============================================================================
 // Files Route Permission Tests
 // =============================================================================
@@ -774,6 +1578,173 @@ func TestFilesRoutes_Allowed_WithPermission(t *testing.T) {
 	}
 }
 
+// =============================================================================
+// Webhooks Route Permission Tests
+// =============================================================================
+
+func TestWebhooksRoutes_Forbidden_WithoutPermission(t *testing.T) {
+	for _, route := range webhooksRoutes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			// Content permissions do not grant webhook management
+			router := setupRouterWithScopes(t, map[string]string{common.ResourceProjects: common.LevelDelete})
+			w := performRequest(t, router, route.method, route.path)
+
+			if w.Code != http.StatusForbidden {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+			}
+		})
+	}
+}
+
+func TestWebhooksRoutes_Allowed_WithPermission(t *testing.T) {
+	for _, route := range webhooksRoutes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			scopes := map[string]string{route.resource: route.level}
+			router := setupRouterWithScopes(t, scopes)
+			w := performRequest(t, router, route.method, route.path)
+
+			// We only verify authorization passes (not 403/401).
+			// Handler may return 400/404/500/503 due to missing body or mock defaults.
+			if w.Code == http.StatusForbidden {
+				t.Errorf("got 403 Forbidden with permission %s:%s", route.resource, route.level)
+			}
+		})
+	}
+}
+
+// =============================================================================
+// Cache Route Permission Tests
+// =============================================================================
+
+func TestCacheRoutes_Forbidden_WithoutPermission(t *testing.T) {
+	for _, route := range cacheRoutes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			// Content permissions do not grant cache purges
+			router := setupRouterWithScopes(t, map[string]string{common.ResourceProjects: common.LevelDelete})
+			w := performRequest(t, router, route.method, route.path)
+
+			if w.Code != http.StatusForbidden {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+			}
+		})
+	}
+}
+
+func TestCacheRoutes_Allowed_WithPermission(t *testing.T) {
+	for _, route := range cacheRoutes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			scopes := map[string]string{route.resource: route.level}
+			router := setupRouterWithScopes(t, scopes)
+			w := performRequest(t, router, route.method, route.path)
+
+			// Purger is not configured in tests, so 503 is expected once authorized
+			if w.Code == http.StatusForbidden {
+				t.Errorf("got 403 Forbidden with permission %s:%s", route.resource, route.level)
+			}
+		})
+	}
+}
+
+// =============================================================================
+// Tags Route Permission Tests
+// =============================================================================
+
+func TestTagsRoutes_Forbidden_WithoutPermission(t *testing.T) {
+	for _, route := range tagsRoutes {
+		t.Run(route.method+" "+route.path, func(t *testing.T) {
+			// Tags are shared by projects and miniatures only
+			router := setupRouterWithScopes(t, map[string]string{common.ResourceSkills: common.LevelDelete})
+			w := performRequest(t, router, route.method, route.path)
+
+			if w.Code != http.StatusForbidden {
+				t.Errorf("status = %d, want %d", w.Code, http.StatusForbidden)
+			}
+		})
+	}
+}
+
+func TestTagsRoutes_Allowed_WithAnyTagResource(t *testing.T) {
+	for _, resource := range models.TagResources {
+		for _, route := range tagsRoutes {
+			t.Run(resource+" "+route.method+" "+route.path, func(t *testing.T) {
+				router := setupRouterWithScopes(t, map[string]string{resource: route.level})
+				w := performRequest(t, router, route.method, route.path)
+
+				// Handler may return 400 due to missing body
+				if w.Code == http.StatusForbidden {
+					t.Errorf("got 403 Forbidden with permission %s:%s", resource, route.level)
+				}
+			})
+		}
+	}
+}
+
+// =============================================================================
+// Dashboard Route Tests
+// =============================================================================
+
+func TestDashboardRoute_AllowedWithoutResourcePermission(t *testing.T) {
+	// Dashboard filters per resource instead of requiring a single permission
+	router := setupRouterWithScopes(t, map[string]string{})
+	w := performRequest(t, router, "GET", "/api/v1/dashboard")
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestSearchRoute_AllowedWithoutResourcePermission(t *testing.T) {
+	// Search covers only the resources the caller can read instead of requiring a single permission
+	router := setupRouterWithScopes(t, map[string]string{})
+	w := performRequest(t, router, "GET", "/api/v1/search?q=kafka")
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestSEOCheckRoute_AllowedWithoutResourcePermission(t *testing.T) {
+	// The check covers only the resources the caller can read instead of requiring a single permission
+	router := setupRouterWithScopes(t, map[string]string{})
+	w := performRequest(t, router, "GET", "/api/v1/seo/check")
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestMissingTranslationsRoute_AllowedWithoutResourcePermission(t *testing.T) {
+	// The report covers only the resources the caller can read instead of requiring a single permission
+	router := setupRouterWithScopes(t, map[string]string{})
+	w := performRequest(t, router, "GET", "/api/v1/translations/missing")
+
+	if w.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusOK)
+	}
+}
+
+func TestRenderMarkdownRoute_AllowedWithoutResourcePermission(t *testing.T) {
+	// The preview reads no resources, so any authenticated caller may render.
+	// The request carries no body, so binding answers 400 instead of rendering.
+	router := setupRouterWithScopes(t, map[string]string{})
+	w := performRequest(t, router, "POST", "/api/v1/render/markdown")
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestEventStreamRoute_AllowedWithoutResourcePermission(t *testing.T) {
+	// The stream filters events per resource instead of requiring a single permission.
+	// No broker is configured in tests, so the handler answers 503 instead of streaming.
+	router := setupRouterWithScopes(t, map[string]string{})
+	w := performRequest(t, router, "GET", "/api/v1/events/stream")
+
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("status = %d, want %d", w.Code, http.StatusServiceUnavailable)
+	}
+}
+
 // =============================================================================
 // Permission Hierarchy Tests
 // =============================================================================
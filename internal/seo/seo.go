@@ -0,0 +1,96 @@
+// Package seo builds the metadata public pages use for search results and
+// link previews.
+//
+// Records have no SEO fields of their own. Stored overrides win; anything
+// left empty is derived from the record: its title, its description cut to
+// fit a search snippet, and its main image. Check flags what search engines
+// and social networks handle badly: titles and descriptions too long to be
+// shown whole, and pages without an image to preview.
+package seo
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// Lengths search results show before cutting text off, in characters
+const (
+	TitleMaxLength       = 60
+	DescriptionMaxLength = 160
+)
+
+// Source is what a record offers for default metadata
+type Source struct {
+	Title       string
+	Description string
+	Image       *models.StorageFile
+}
+
+// Resolve merges the stored overrides with the defaults from source and
+// lists the issues of the result. overrideImage is the file of
+// stored.OGImageFileID, if any.
+func Resolve(entity string, id int64, source Source, stored models.SEOMetadata, overrideImage *models.StorageFile) models.SEO {
+	meta := models.SEO{
+		Entity:          entity,
+		EntityID:        id,
+		MetaTitle:       strings.TrimSpace(stored.MetaTitle),
+		MetaDescription: strings.TrimSpace(stored.MetaDescription),
+		CanonicalURL:    strings.TrimSpace(stored.CanonicalURL),
+		OGImage:         overrideImage,
+		Overrides:       stored,
+	}
+	if meta.MetaTitle == "" {
+		meta.MetaTitle = strings.Join(strings.Fields(source.Title), " ")
+	}
+	if meta.MetaDescription == "" {
+		meta.MetaDescription = shorten(source.Description, DescriptionMaxLength)
+	}
+	if meta.OGImage == nil {
+		meta.OGImage = source.Image
+	}
+	meta.Issues = Check(meta)
+	return meta
+}
+
+// Check lists the issues of resolved metadata
+func Check(meta models.SEO) []models.SEOIssue {
+	issues := []models.SEOIssue{}
+	add := func(field, message string) {
+		issues = append(issues, models.SEOIssue{Field: field, Message: message})
+	}
+
+	switch n := utf8.RuneCountInString(meta.MetaTitle); {
+	case n == 0:
+		add("metaTitle", "is missing")
+	case n > TitleMaxLength:
+		add("metaTitle", fmt.Sprintf("is %d characters, search results show about %d", n, TitleMaxLength))
+	}
+	switch n := utf8.RuneCountInString(meta.MetaDescription); {
+	case n == 0:
+		add("metaDescription", "is missing, search engines will pick text from the page")
+	case n > DescriptionMaxLength:
+		add("metaDescription", fmt.Sprintf("is %d characters, search results show about %d", n, DescriptionMaxLength))
+	}
+	if meta.OGImage == nil {
+		add("ogImage", "is missing, shared links show no preview")
+	}
+	return issues
+}
+
+// shorten collapses whitespace in s and cuts it to max characters at a word
+// boundary, marking the cut with an ellipsis
+func shorten(s string, max int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)[:max-1]
+	cut := string(runes)
+	if i := strings.LastIndexByte(cut, ' '); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " ,.;:-") + "…"
+}
@@ -0,0 +1,84 @@
+package seo
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+func TestResolve(t *testing.T) {
+	image := &models.StorageFile{ID: 3}
+	override := &models.StorageFile{ID: 9}
+	long := strings.Repeat("Streams orders through Kafka topics. ", 10)
+
+	tests := []struct {
+		name          string
+		source        Source
+		stored        models.SEOMetadata
+		overrideImage *models.StorageFile
+		wantTitle     string
+		wantImage     int64
+	}{
+		{
+			name:      "defaults from the record",
+			source:    Source{Title: "  Order   Pipeline ", Description: long, Image: image},
+			wantTitle: "Order Pipeline",
+			wantImage: 3,
+		},
+		{
+			name:          "overrides win",
+			source:        Source{Title: "Order Pipeline", Description: long, Image: image},
+			stored:        models.SEOMetadata{MetaTitle: "Kafka order pipeline", MetaDescription: "Orders on Kafka."},
+			overrideImage: override,
+			wantTitle:     "Kafka order pipeline",
+			wantImage:     9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meta := Resolve(models.EntityPortfolioProject, 12, tt.source, tt.stored, tt.overrideImage)
+
+			if meta.MetaTitle != tt.wantTitle || meta.OGImage == nil || meta.OGImage.ID != tt.wantImage {
+				t.Errorf("Resolve() = %q image %+v, want %q image %d", meta.MetaTitle, meta.OGImage, tt.wantTitle, tt.wantImage)
+			}
+			if n := utf8.RuneCountInString(meta.MetaDescription); n > DescriptionMaxLength {
+				t.Errorf("metaDescription is %d characters", n)
+			}
+			if len(meta.Issues) != 0 {
+				t.Errorf("issues = %+v, want none", meta.Issues)
+			}
+		})
+	}
+}
+
+func TestDefaultDescriptionCutAtWord(t *testing.T) {
+	meta := Resolve(models.EntityMiniatureTheme, 1, Source{Title: "Grimdark", Description: strings.Repeat("word ", 50)}, models.SEOMetadata{}, nil)
+
+	if !strings.HasSuffix(meta.MetaDescription, "word…") {
+		t.Errorf("metaDescription = %q, want a cut after a whole word", meta.MetaDescription)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	meta := models.SEO{
+		MetaTitle:       strings.Repeat("t", TitleMaxLength+12),
+		MetaDescription: "",
+	}
+
+	var got []string
+	for _, issue := range Check(meta) {
+		got = append(got, issue.Field+": "+issue.Message)
+	}
+	want := []string{
+		"metaTitle: is 72 characters, search results show about 60",
+		"metaDescription: is missing, search engines will pick text from the page",
+		"ogImage: is missing, shared links show no preview",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Check() = %q, want %q", got, want)
+	}
+}
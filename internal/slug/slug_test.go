@@ -0,0 +1,61 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"Order Pipeline", "order-pipeline"},
+		{"  Space Marine (copy) ", "space-marine-copy"},
+		{"Rīgas Ģimnāzija — Šķūnis", "rigas-gimnazija-skunis"},
+		{"Straße & Größe", "strasse-grosse"},
+		{"Ёлка на Подъезде", "yolka-na-podezde"},
+		{"Café v2.0", "cafe-v2-0"},
+		{"!!!", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := Make(tt.in); got != tt.want {
+				t.Errorf("Make(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLength(t *testing.T) {
+	long := strings.Repeat("word ", 30)
+
+	made := Make(long)
+	if len(made) > MaxLength || strings.HasSuffix(made, "-") || !Valid(made) {
+		t.Errorf("Make(long) = %q (%d bytes)", made, len(made))
+	}
+
+	suffixed := WithSuffix(made, 12)
+	if len(suffixed) > MaxLength || !strings.HasSuffix(suffixed, "-12") || !Valid(suffixed) {
+		t.Errorf("WithSuffix() = %q (%d bytes)", suffixed, len(suffixed))
+	}
+}
+
+func TestValid(t *testing.T) {
+	tests := map[string]bool{
+		"order-pipeline": true,
+		"v2":             true,
+		"Order-Pipeline": false,
+		"order--queue":   false,
+		"-order":         false,
+		"order pipeline": false,
+		"":               false,
+	}
+
+	for in, want := range tests {
+		if got := Valid(in); got != want {
+			t.Errorf("Valid(%q) = %v, want %v", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+// Package stream fans content change events out to live admin UI clients.
+// Recent events are kept in a bounded ring buffer so a reconnecting client can
+// resume from its Last-Event-ID without missing changes.
+package stream
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+)
+
+// Defaults used when Config fields are zero
+const (
+	DefaultBufferSize        = 1000
+	DefaultHeartbeatInterval = 15 * time.Second
+	clientBufferSize         = 64
+)
+
+// Entry is a buffered event with its stream ID
+type Entry struct {
+	ID    string
+	Event events.Event
+}
+
+// Config configures buffering and keep-alives
+type Config struct {
+	// BufferSize is how many recent events are kept for resuming clients
+	BufferSize int
+	// HeartbeatInterval is how often idle streams receive a keep-alive comment
+	HeartbeatInterval time.Duration
+}
+
+// Subscription receives live entries. C is closed when the client falls too
+// far behind or the broker shuts down; the client should reconnect and resume.
+type Subscription struct {
+	C <-chan Entry
+	c chan Entry
+}
+
+// Broker buffers events and delivers them to subscribers
+type Broker struct {
+	cfg Config
+	// epoch distinguishes stream IDs of this process from a previous one
+	epoch string
+
+	mu     sync.Mutex
+	ring   []Entry
+	next   int
+	size   int
+	seq    uint64
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+// NewBroker creates a broker
+func NewBroker(cfg Config) *Broker {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = DefaultBufferSize
+	}
+	if cfg.HeartbeatInterval <= 0 {
+		cfg.HeartbeatInterval = DefaultHeartbeatInterval
+	}
+	return &Broker{
+		cfg:   cfg,
+		epoch: strconv.FormatInt(time.Now().UnixNano(), 36),
+		ring:  make([]Entry, cfg.BufferSize),
+		subs:  make(map[*Subscription]struct{}),
+	}
+}
+
+// HeartbeatInterval returns how often idle streams should send a keep-alive
+func (b *Broker) HeartbeatInterval() time.Duration {
+	return b.cfg.HeartbeatInterval
+}
+
+// Publish implements events.Publisher. It never blocks: a subscriber whose
+// buffer is full is disconnected and can resume from the ring buffer.
+func (b *Broker) Publish(_ context.Context, event events.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+
+	b.seq++
+	entry := Entry{ID: b.epoch + "-" + strconv.FormatUint(b.seq, 10), Event: event}
+	b.ring[b.next] = entry
+	b.next = (b.next + 1) % len(b.ring)
+	if b.size < len(b.ring) {
+		b.size++
+	}
+
+	for sub := range b.subs {
+		select {
+		case sub.c <- entry:
+		default:
+			delete(b.subs, sub)
+			close(sub.c)
+		}
+	}
+}
+
+// Subscribe registers a subscriber. When lastEventID is set, the entries after
+// it are returned for replay. complete is false when the ID is unknown (for
+// example from before a restart) or already evicted from the buffer: the
+// client may have missed events and should reload its data.
+func (b *Broker) Subscribe(lastEventID string) (sub *Subscription, replay []Entry, complete bool) {
+	c := make(chan Entry, clientBufferSize)
+	sub = &Subscription{C: c, c: c}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(c)
+		return sub, nil, true
+	}
+	b.subs[sub] = struct{}{}
+
+	if lastEventID == "" {
+		return sub, nil, true
+	}
+	replay, complete = b.since(lastEventID)
+	return sub, replay, complete
+}
+
+// Unsubscribe removes a subscriber
+func (b *Broker) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.c)
+	}
+}
+
+// Run closes every subscription when ctx is cancelled so open streams end
+// promptly on shutdown
+func (b *Broker) Run(ctx context.Context) {
+	<-ctx.Done()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for sub := range b.subs {
+		delete(b.subs, sub)
+		close(sub.c)
+	}
+}
+
+// since returns buffered entries after the given ID. Must be called with mu held.
+func (b *Broker) since(lastEventID string) ([]Entry, bool) {
+	epoch, rawSeq, ok := strings.Cut(lastEventID, "-")
+	if !ok || epoch != b.epoch {
+		return nil, false
+	}
+	seq, err := strconv.ParseUint(rawSeq, 10, 64)
+	if err != nil || seq > b.seq {
+		return nil, false
+	}
+
+	oldest := b.seq - uint64(b.size) + 1
+	if seq+1 < oldest {
+		// Events between seq and the oldest buffered one were evicted
+		return nil, false
+	}
+	return b.buffered(int(seq + 1 - oldest)), true
+}
+
+// buffered returns the buffered entries in order, skipping the first skip.
+// Must be called with mu held.
+func (b *Broker) buffered(skip int) []Entry {
+	if skip >= b.size {
+		return nil
+	}
+	entries := make([]Entry, 0, b.size-skip)
+	start := (b.next - b.size + len(b.ring)) % len(b.ring)
+	for i := skip; i < b.size; i++ {
+		entries = append(entries, b.ring[(start+i)%len(b.ring)])
+	}
+	return entries
+}
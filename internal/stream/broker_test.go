@@ -0,0 +1,86 @@
+package stream
+
+import (
+	"context"
+	"testing"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+func publishN(b *Broker, n int) {
+	for i := 1; i <= n; i++ {
+		b.Publish(context.Background(), events.New(models.EntitySkill, events.ActionUpdated, int64(i), ""))
+	}
+}
+
+func TestSubscribe_ReplaysAfterLastEventID(t *testing.T) {
+	b := NewBroker(Config{BufferSize: 10})
+	sub, _, _ := b.Subscribe("")
+	publishN(b, 5)
+
+	var ids []string
+	for i := 0; i < 5; i++ {
+		ids = append(ids, (<-sub.C).ID)
+	}
+	b.Unsubscribe(sub)
+
+	_, replay, complete := b.Subscribe(ids[1])
+	if !complete {
+		t.Fatal("Subscribe() complete = false for a buffered ID")
+	}
+	if len(replay) != 3 || replay[0].ID != ids[2] || replay[2].ID != ids[4] {
+		t.Errorf("replay = %v, want the 3 events after %s", replay, ids[1])
+	}
+
+	if _, replay, complete := b.Subscribe(ids[4]); !complete || len(replay) != 0 {
+		t.Errorf("Subscribe(latest) = %d entries, complete %v; want none, complete", len(replay), complete)
+	}
+}
+
+func TestSubscribe_EvictedOrUnknownIDIsIncomplete(t *testing.T) {
+	b := NewBroker(Config{BufferSize: 3})
+	sub, _, _ := b.Subscribe("")
+	publishN(b, 1)
+	first := (<-sub.C).ID
+	publishN(b, 4) // ring now holds events 3-5; event 2 was evicted
+
+	if _, replay, complete := b.Subscribe(first); complete || replay != nil {
+		t.Errorf("Subscribe(evicted) complete = %v, replay = %d; want incomplete, none", complete, len(replay))
+	}
+	if _, _, complete := b.Subscribe("other-epoch-1"); complete {
+		t.Error("Subscribe(unknown epoch) complete = true, want false")
+	}
+}
+
+func TestPublish_DisconnectsSlowSubscriber(t *testing.T) {
+	b := NewBroker(Config{BufferSize: 500})
+	sub, _, _ := b.Subscribe("")
+	publishN(b, clientBufferSize+1)
+
+	received := 0
+	for range sub.C {
+		received++
+	}
+	if received != clientBufferSize {
+		t.Errorf("received %d entries before disconnect, want %d", received, clientBufferSize)
+	}
+}
+
+func TestRun_ClosesSubscriptionsOnShutdown(t *testing.T) {
+	b := NewBroker(Config{})
+	sub, _, _ := b.Subscribe("")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	b.Run(ctx)
+
+	if _, ok := <-sub.C; ok {
+		t.Error("subscription still open after shutdown")
+	}
+	// Publishing after shutdown is a no-op and late subscribers get a closed channel
+	publishN(b, 1)
+	late, _, _ := b.Subscribe("")
+	if _, ok := <-late.C; ok {
+		t.Error("late subscription open after shutdown")
+	}
+}
@@ -0,0 +1,185 @@
+// Package translation lists the text fields of records that can be
+// translated and picks the content language of a request.
+//
+// Records are written in the default locale. Translations into the other
+// locales are stored per record, field and locale; a field without a
+// translation keeps the record's own text.
+package translation
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// DefaultLocales are the content languages when none are configured: English
+// records with Latvian translations
+var DefaultLocales = []string{"en", "lv"}
+
+// Field is a translatable text field of a record
+type Field struct {
+	// Name is the JSON name of the field
+	Name   string
+	Column string
+	// List fields hold a list of strings
+	List bool
+	// Markdown fields hold markdown, validated like the record's own text
+	Markdown bool
+}
+
+var fields = map[string][]Field{
+	models.EntityProfile:        {{Name: "title", Column: "title"}, {Name: "tagline", Column: "bio"}},
+	models.EntityWorkExperience: {{Name: "description", Column: "description"}},
+	models.EntityPortfolioProject: {
+		{Name: "description", Column: "description"},
+		{Name: "longDescription", Column: "long_description", Markdown: true},
+		{Name: "challenges", Column: "challenges", List: true},
+		{Name: "learnings", Column: "learnings", List: true},
+	},
+	models.EntityMiniatureProject: {{Name: "description", Column: "description", Markdown: true}},
+}
+
+// Entities are the entity types with translatable fields
+var Entities = []string{models.EntityProfile, models.EntityWorkExperience, models.EntityPortfolioProject, models.EntityMiniatureProject}
+
+// Fields returns the translatable fields of entity
+func Fields(entity string) []Field {
+	return fields[entity]
+}
+
+// Lookup finds the translatable field called name in entity
+func Lookup(entity, name string) (Field, bool) {
+	for _, field := range fields[entity] {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return Field{}, false
+}
+
+// Encode turns the fields of a validated request into stored values: text is
+// trimmed, list items are trimmed and kept as a JSON array. Empty fields are
+// left out.
+func Encode(entity string, raw map[string]json.RawMessage) map[string]string {
+	values := make(map[string]string, len(raw))
+	for name, value := range raw {
+		field, ok := Lookup(entity, name)
+		if !ok {
+			continue
+		}
+		if !field.List {
+			var text string
+			if json.Unmarshal(value, &text) != nil {
+				continue
+			}
+			if strings.TrimSpace(text) != "" {
+				values[name] = strings.TrimSpace(text)
+			}
+			continue
+		}
+		var items []string
+		if json.Unmarshal(value, &items) != nil {
+			continue
+		}
+		kept := make([]string, 0, len(items))
+		for _, item := range items {
+			if trimmed := strings.TrimSpace(item); trimmed != "" {
+				kept = append(kept, trimmed)
+			}
+		}
+		if len(kept) > 0 {
+			encoded, _ := json.Marshal(kept)
+			values[name] = string(encoded)
+		}
+	}
+	return values
+}
+
+// Decode returns a stored value as a request would send it: a string, or a
+// list of strings for list fields
+func Decode(entity, name, value string) interface{} {
+	field, _ := Lookup(entity, name)
+	if !field.List {
+		return value
+	}
+	items := []string{}
+	_ = json.Unmarshal([]byte(value), &items)
+	return items
+}
+
+// Apply replaces the translatable fields of record with the stored values
+// that are set. record is a pointer to a model of an entity in Entities.
+func Apply(record interface{}, values map[string]string) {
+	if len(values) == 0 {
+		return
+	}
+	text := func(name string, target *string) {
+		if value, ok := values[name]; ok {
+			*target = value
+		}
+	}
+	list := func(name string, target *[]string) {
+		var items []string
+		if value, ok := values[name]; ok && json.Unmarshal([]byte(value), &items) == nil {
+			*target = items
+		}
+	}
+
+	switch r := record.(type) {
+	case *models.Profile:
+		text("title", &r.Title)
+		text("tagline", &r.Bio)
+	case *models.WorkExperience:
+		text("description", &r.Description)
+	case *models.PortfolioProject:
+		text("description", &r.Description)
+		text("longDescription", &r.LongDescription)
+		list("challenges", &r.Challenges)
+		list("learnings", &r.Learnings)
+	case *models.MiniatureProject:
+		text("description", &r.Description)
+	}
+}
+
+// Negotiate picks the locale that best matches an Accept-Language header.
+// A language range matches a locale exactly or by its primary subtag (lv-LV
+// matches lv). locales[0], the default locale, is returned when nothing else
+// matches.
+func Negotiate(header string, locales []string) string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var ranges []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" && q > 0 {
+			ranges = append(ranges, weighted{tag, q})
+		}
+	}
+	sort.SliceStable(ranges, func(i, j int) bool { return ranges[i].q > ranges[j].q })
+
+	for _, r := range ranges {
+		if r.tag == "*" {
+			break
+		}
+		primary, _, _ := strings.Cut(r.tag, "-")
+		for _, locale := range locales {
+			if r.tag == locale || primary == locale {
+				return locale
+			}
+		}
+	}
+	return locales[0]
+}
@@ -0,0 +1,78 @@
+package translation
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+func TestNegotiate(t *testing.T) {
+	locales := []string{"en", "lv"}
+
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"", "en"},
+		{"lv", "lv"},
+		{"lv-LV,lv;q=0.9,en;q=0.8", "lv"},
+		{"en-GB,lv;q=0.5", "en"},
+		{"de-DE,lv;q=0.7", "lv"},
+		{"en;q=0.4, LV;q=0.6", "lv"},
+		{"lv;q=0,en", "en"},
+		{"lv;q=abc", "en"},
+		{"*", "en"},
+		{"de", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			if got := Negotiate(tt.header, locales); got != tt.want {
+				t.Errorf("Negotiate(%q) = %q, want %q", tt.header, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeAndApply(t *testing.T) {
+	raw := map[string]json.RawMessage{
+		"description": json.RawMessage(`"  Pasūtījumu plūsma caur Kafka "`),
+		"challenges":  json.RawMessage(`["Secība", " ", "Dublikāti"]`),
+		"learnings":   json.RawMessage(`[]`),
+		"title":       json.RawMessage(`"not translatable for projects"`),
+	}
+
+	values := Encode(models.EntityPortfolioProject, raw)
+
+	want := map[string]string{
+		"description": "Pasūtījumu plūsma caur Kafka",
+		"challenges":  `["Secība","Dublikāti"]`,
+	}
+	if fmt.Sprint(values) != fmt.Sprint(want) {
+		t.Fatalf("Encode() = %v, want %v", values, want)
+	}
+
+	project := models.PortfolioProject{
+		Title:       "Order pipeline",
+		Description: "Order pipeline on Kafka",
+		Challenges:  []string{"Ordering", "Duplicates"},
+		Learnings:   []string{"Idempotency"},
+	}
+	Apply(&project, values)
+
+	if project.Title != "Order pipeline" || project.Description != want["description"] ||
+		fmt.Sprint(project.Challenges) != "[Secība Dublikāti]" || fmt.Sprint(project.Learnings) != "[Idempotency]" {
+		t.Errorf("Apply() = %+v, want translated description and challenges only", project)
+	}
+}
+
+func TestDecode(t *testing.T) {
+	if got := Decode(models.EntityPortfolioProject, "challenges", `["Secība"]`); fmt.Sprint(got) != "[Secība]" {
+		t.Errorf("Decode(challenges) = %v, want a list", got)
+	}
+	if got := Decode(models.EntityProfile, "tagline", "Programmētājs"); got != "Programmētājs" {
+		t.Errorf("Decode(tagline) = %v, want the text", got)
+	}
+}
@@ -0,0 +1,212 @@
+package validation
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/slug"
+	"github.com/GunarsK-portfolio/admin-api/internal/translation"
+)
+
+// Profile checks the social links
+func Profile(profile *models.Profile) Errors {
+	var errs Errors
+	errs.webURL("github", profile.Github, "github.com")
+	errs.webURL("linkedin", profile.Linkedin, "linkedin.com")
+	return errs
+}
+
+// WorkExperience checks the dates and that a current position has no end date
+func WorkExperience(exp *models.WorkExperience) Errors {
+	var errs Errors
+	errs.dateRange("startDate", &exp.StartDate, "endDate", exp.EndDate)
+	if exp.IsCurrent && exp.EndDate != nil && *exp.EndDate != "" {
+		errs.Add("endDate", "must be empty when isCurrent is true")
+	}
+	return errs
+}
+
+// Certification checks the dates and the credential link
+func Certification(cert *models.Certification) Errors {
+	var errs Errors
+	errs.dateRange("issueDate", &cert.IssueDate, "expiryDate", cert.ExpiryDate)
+	errs.webURL("credentialUrl", cert.CredentialURL)
+	return errs
+}
+
+// Education checks the dates and the display order
+func Education(education *models.Education) Errors {
+	var errs Errors
+	errs.dateRange("startDate", &education.StartDate, "endDate", education.EndDate)
+	errs.notNegative("displayOrder", education.DisplayOrder)
+	return errs
+}
+
+// Testimonial checks that at most one record is linked and the display order
+func Testimonial(testimonial *models.Testimonial) Errors {
+	var errs Errors
+	if testimonial.WorkExperienceID != nil && testimonial.PortfolioProjectID != nil {
+		errs.Add("portfolioProjectId", "must be empty when workExperienceId is set")
+	}
+	errs.notNegative("displayOrder", testimonial.DisplayOrder)
+	return errs
+}
+
+// PortfolioProject checks the dates, that an ongoing project has no end date,
+// the links and the markdown long description
+func PortfolioProject(project *models.PortfolioProject) Errors {
+	var errs Errors
+	errs.dateRange("startDate", project.StartDate, "endDate", project.EndDate)
+	if project.IsOngoing && project.EndDate != nil && *project.EndDate != "" {
+		errs.Add("endDate", "must be empty when isOngoing is true")
+	}
+	errs.webURL("githubUrl", project.GithubURL, "github.com")
+	errs.webURL("liveUrl", project.LiveURL)
+	if project.TeamSize != nil && *project.TeamSize < 1 {
+		errs.Add("teamSize", "must be at least 1")
+	}
+	errs.markdown("longDescription", project.LongDescription)
+	errs.notNegative("displayOrder", project.DisplayOrder)
+	return errs
+}
+
+// MiniatureProject checks the completion date, the markdown description and
+// counters
+func MiniatureProject(project *models.MiniatureProject) Errors {
+	var errs Errors
+	errs.date("completedDate", project.CompletedDate)
+	errs.markdown("description", project.Description)
+	if project.TimeSpent != nil && *project.TimeSpent < 0 {
+		errs.Add("timeSpent", "must not be negative")
+	}
+	errs.notNegative("displayOrder", project.DisplayOrder)
+	return errs
+}
+
+// MiniatureTheme checks the display order
+func MiniatureTheme(theme *models.MiniatureTheme) Errors {
+	var errs Errors
+	errs.notNegative("displayOrder", theme.DisplayOrder)
+	return errs
+}
+
+// MiniaturePaint checks the color is #RGB or #RRGGBB
+func MiniaturePaint(paint *models.MiniaturePaint) Errors {
+	var errs Errors
+	if paint.ColorHex != nil && *paint.ColorHex != "" && !colorHexPattern.MatchString(*paint.ColorHex) {
+		errs.Add("colorHex", "must be a color in #RGB or #RRGGBB format")
+	}
+	return errs
+}
+
+// Article checks the markdown body
+func Article(article *models.Article) Errors {
+	var errs Errors
+	errs.markdown("body", article.Body)
+	return errs
+}
+
+// Skill checks the display order
+func Skill(skill *models.Skill) Errors {
+	var errs Errors
+	errs.notNegative("displayOrder", skill.DisplayOrder)
+	return errs
+}
+
+// SkillType checks the display order
+func SkillType(skillType *models.SkillType) Errors {
+	var errs Errors
+	errs.notNegative("displayOrder", skillType.DisplayOrder)
+	return errs
+}
+
+// Webhook checks the URL scheme and event filters
+func Webhook(webhook *models.Webhook) Errors {
+	var errs Errors
+	errs.webURL("url", webhook.URL)
+	for i, filter := range webhook.Events {
+		if !events.ValidFilter(filter) {
+			errs.Add(fmt.Sprintf("events[%d]", i), fmt.Sprintf("%q is not a known event filter", filter))
+		}
+	}
+	return errs
+}
+
+// MergeRequest checks no source is listed twice
+func MergeRequest(req *models.MergeRequest) Errors {
+	var errs Errors
+	first := make(map[int64]int, len(req.SourceIDs))
+	for i, id := range req.SourceIDs {
+		if j, dup := first[id]; dup {
+			errs.Add(fmt.Sprintf("sourceIds[%d]", i), fmt.Sprintf("duplicates sourceIds[%d]", j))
+			continue
+		}
+		first[id] = i
+	}
+	return errs
+}
+
+// Tag checks the name is not blank
+func Tag(tag *models.Tag) Errors {
+	var errs Errors
+	if tag.Name != "" && strings.TrimSpace(tag.Name) == "" {
+		errs.Add("name", "must not be blank")
+	}
+	return errs
+}
+
+// SlugRequest checks the slug has the shape of generated slugs
+func SlugRequest(req *models.SlugRequest) Errors {
+	var errs Errors
+	if req.Slug != "" && !slug.Valid(req.Slug) {
+		errs.Add("slug", "must be lowercase letters and digits separated by single hyphens")
+	}
+	return errs
+}
+
+// SEOMetadata checks the canonical URL
+func SEOMetadata(meta *models.SEOMetadata) Errors {
+	var errs Errors
+	errs.webURL("canonicalUrl", meta.CanonicalURL)
+	return errs
+}
+
+// TranslationRequest returns the rule for translations of entity: every field
+// must be translatable and hold text, or a list of texts for list fields
+func TranslationRequest(entity string) func(*models.TranslationRequest) Errors {
+	return func(req *models.TranslationRequest) Errors {
+		var errs Errors
+		names := make([]string, 0, len(req.Fields))
+		for name := range req.Fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			field, ok := translation.Lookup(entity, name)
+			if !ok {
+				errs.Add("fields."+name, "is not a translatable field")
+				continue
+			}
+			value := req.Fields[name]
+			if field.List {
+				var items []string
+				if json.Unmarshal(value, &items) != nil {
+					errs.Add("fields."+name, "must be a list of strings")
+				}
+				continue
+			}
+			var text string
+			if json.Unmarshal(value, &text) != nil {
+				errs.Add("fields."+name, "must be a string")
+			} else if field.Markdown {
+				errs.markdown("fields."+name, text)
+			}
+		}
+		return errs
+	}
+}
@@ -0,0 +1,111 @@
+// Package validation enforces domain rules that binding tags cannot express:
+// dates that must be in order, flags that exclude other fields, URL and color
+// formats, and markdown length. Every rule is checked so a client sees all violations in one
+// response instead of fixing them one round trip at a time.
+package validation
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/markdown"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+)
+
+const dateLayout = "2006-01-02"
+
+var colorHexPattern = regexp.MustCompile(`^#([0-9A-Fa-f]{3}|[0-9A-Fa-f]{6})$`)
+
+// Errors collects the violations found in one request body
+type Errors []problem.FieldError
+
+// Add records a violation of field
+func (e *Errors) Add(field, message string) {
+	*e = append(*e, problem.FieldError{Field: field, Message: message})
+}
+
+// Merge appends violations from another check, skipping fields that already
+// have one (binding tags and domain rules often catch the same bad value)
+func (e *Errors) Merge(other []problem.FieldError) {
+	seen := make(map[string]bool, len(*e))
+	for _, fe := range *e {
+		seen[fe.Field] = true
+	}
+	for _, fe := range other {
+		if !seen[fe.Field] {
+			*e = append(*e, fe)
+		}
+	}
+}
+
+// date parses an optional date field, recording a violation when it is set
+// but not a date. ok is false when the field is empty or invalid.
+func (e *Errors) date(field string, value *string) (time.Time, bool) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return time.Time{}, false
+	}
+	raw := strings.TrimSpace(*value)
+	// Accept timestamps too: dates read back from the database may carry a time
+	for _, layout := range []string{dateLayout, time.RFC3339} {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t, true
+		}
+	}
+	e.Add(field, "must be a date in YYYY-MM-DD format")
+	return time.Time{}, false
+}
+
+// dateRange checks both dates and that end is not before start
+func (e *Errors) dateRange(startField string, start *string, endField string, end *string) {
+	startDate, startOK := e.date(startField, start)
+	endDate, endOK := e.date(endField, end)
+	if startOK && endOK && endDate.Before(startDate) {
+		e.Add(endField, fmt.Sprintf("must not be before %s", startField))
+	}
+}
+
+// webURL checks an optional absolute http(s) URL. When hosts is not empty the
+// URL must point at one of them or a subdomain.
+func (e *Errors) webURL(field, value string, hosts ...string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	parsed, err := url.Parse(value)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		e.Add(field, "must be an absolute http or https URL")
+		return
+	}
+	if len(hosts) == 0 {
+		return
+	}
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range hosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return
+		}
+	}
+	e.Add(field, "must be a "+strings.Join(hosts, " or ")+" URL")
+}
+
+// markdown checks a markdown text is not longer than the renderer accepts
+// and has nothing the sanitizer would remove
+func (e *Errors) markdown(field, value string) {
+	if utf8.RuneCountInString(value) > markdown.MaxLength {
+		e.Add(field, fmt.Sprintf("must be at most %d characters", markdown.MaxLength))
+		return
+	}
+	if removed := markdown.Unsafe(value); len(removed) > 0 {
+		e.Add(field, "must not contain "+strings.Join(removed, ", "))
+	}
+}
+
+// notNegative checks counters such as displayOrder
+func (e *Errors) notNegative(field string, value int) {
+	if value < 0 {
+		e.Add(field, "must not be negative")
+	}
+}
@@ -0,0 +1,136 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+	"github.com/GunarsK-portfolio/admin-api/internal/problem"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestDateRules(t *testing.T) {
+	tests := []struct {
+		name string
+		errs Errors
+		want []problem.FieldError
+	}{
+		{
+			name: "current position with an earlier end date",
+			errs: WorkExperience(&models.WorkExperience{StartDate: "2022-05-01", EndDate: ptr("2021-01-01"), IsCurrent: true}),
+			want: []problem.FieldError{
+				{Field: "endDate", Message: "must not be before startDate"},
+				{Field: "endDate", Message: "must be empty when isCurrent is true"},
+			},
+		},
+		{
+			name: "finished position",
+			errs: WorkExperience(&models.WorkExperience{StartDate: "2020-01-01", EndDate: ptr("2021-06-30T00:00:00Z")}),
+		},
+		{
+			name: "malformed start date",
+			errs: WorkExperience(&models.WorkExperience{StartDate: "01/02/2020"}),
+			want: []problem.FieldError{{Field: "startDate", Message: "must be a date in YYYY-MM-DD format"}},
+		},
+		{
+			name: "certification expiring before issue",
+			errs: Certification(&models.Certification{IssueDate: "2024-01-15", ExpiryDate: ptr("2023-01-15")}),
+			want: []problem.FieldError{{Field: "expiryDate", Message: "must not be before issueDate"}},
+		},
+		{
+			name: "ongoing project with an end date",
+			errs: PortfolioProject(&models.PortfolioProject{IsOngoing: true, StartDate: ptr("2024-01-01"), EndDate: ptr("2024-02-01")}),
+			want: []problem.FieldError{{Field: "endDate", Message: "must be empty when isOngoing is true"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if fmt.Sprint(tt.errs) != fmt.Sprint(tt.want) {
+				t.Errorf("errors = %+v, want %+v", tt.errs, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatRules(t *testing.T) {
+	tests := []struct {
+		name string
+		errs Errors
+		want []problem.FieldError
+	}{
+		{
+			name: "project links",
+			errs: PortfolioProject(&models.PortfolioProject{GithubURL: "https://gitlab.com/me/app", LiveURL: "ftp://example.com", TeamSize: ptr(0)}),
+			want: []problem.FieldError{
+				{Field: "githubUrl", Message: "must be a github.com URL"},
+				{Field: "liveUrl", Message: "must be an absolute http or https URL"},
+				{Field: "teamSize", Message: "must be at least 1"},
+			},
+		},
+		{
+			name: "profile links",
+			errs: Profile(&models.Profile{Github: "https://github.com/me", Linkedin: "https://www.linkedin.com/in/me"}),
+		},
+		{
+			name: "profile linkedin elsewhere",
+			errs: Profile(&models.Profile{Linkedin: "https://example.com/in/me"}),
+			want: []problem.FieldError{{Field: "linkedin", Message: "must be a linkedin.com URL"}},
+		},
+		{
+			name: "credential url",
+			errs: Certification(&models.Certification{IssueDate: "2024-01-15", CredentialURL: "credly.com/badges/1"}),
+			want: []problem.FieldError{{Field: "credentialUrl", Message: "must be an absolute http or https URL"}},
+		},
+		{
+			name: "markdown length",
+			errs: MiniatureProject(&models.MiniatureProject{Description: strings.Repeat("ā", 20001)}),
+			want: []problem.FieldError{{Field: "description", Message: "must be at most 20000 characters"}},
+		},
+		{
+			name: "markdown aligned table",
+			errs: MiniatureProject(&models.MiniatureProject{Description: "| Paint | Coats |\n|:--|--:|\n| Macragge Blue | 2 |"}),
+		},
+		{
+			name: "unsafe markdown",
+			errs: PortfolioProject(&models.PortfolioProject{TeamSize: ptr(1), LongDescription: "Hi <b onclick=\"x()\">there</b>"}),
+			want: []problem.FieldError{{Field: "longDescription", Message: "must not contain onclick attribute on <b>"}},
+		},
+		{
+			name: "paint colors",
+			errs: append(MiniaturePaint(&models.MiniaturePaint{ColorHex: ptr("#FF573380")}), MiniaturePaint(&models.MiniaturePaint{ColorHex: ptr("#f00")})...),
+			want: []problem.FieldError{{Field: "colorHex", Message: "must be a color in #RGB or #RRGGBB format"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if fmt.Sprint(tt.errs) != fmt.Sprint(tt.want) {
+				t.Errorf("errors = %+v, want %+v", tt.errs, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorsMerge(t *testing.T) {
+	var errs Errors
+	errs.Merge([]problem.FieldError{{Field: "colorHex", Message: "must be a hex color such as #1a2b3c"}})
+	errs.Merge(Errors{
+		{Field: "colorHex", Message: "must be a color in #RGB or #RRGGBB format"},
+		{Field: "name", Message: "is required"},
+		{Field: "name", Message: "must be at most 100 characters long"},
+	})
+
+	want := []problem.FieldError{
+		{Field: "colorHex", Message: "must be a hex color such as #1a2b3c"},
+		{Field: "name", Message: "is required"},
+		{Field: "name", Message: "must be at most 100 characters long"},
+	}
+	if fmt.Sprint(errs) != fmt.Sprint(want) {
+		t.Errorf("Merge() = %+v, want %+v", errs, want)
+	}
+}
@@ -0,0 +1,350 @@
+// Package webhooks delivers content change events to registered outbound
+// webhooks. Deliveries are recorded as pending rows in the same transaction as
+// the change, so they survive restarts. Payloads are signed with HMAC-SHA256,
+// delivered asynchronously with one worker per webhook and retried with
+// exponential backoff; every attempt is kept in a delivery log.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+// Delivery request headers
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// TestEventType is the event sent by the "send test event" endpoint
+const TestEventType = "webhook.test"
+
+// Defaults used when Config fields are zero
+const (
+	DefaultMaxAttempts  = 5
+	DefaultBaseDelay    = 30 * time.Second
+	DefaultMaxDelay     = time.Hour
+	DefaultPollInterval = 15 * time.Second
+	DefaultTimeout      = 10 * time.Second
+	dueBatchSize        = 50
+	maxErrorLength      = 500
+)
+
+// Store is the persistence the dispatcher needs (implemented by repository.Repository)
+type Store interface {
+	GetWebhookByID(ctx context.Context, id int64) (*models.Webhook, error)
+	GetDueWebhookDeliveries(ctx context.Context, now time.Time, excludeWebhookIDs []int64, limit int) ([]models.WebhookDelivery, error)
+	CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+	UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error
+}
+
+// Config configures delivery and retry behaviour
+type Config struct {
+	// MaxAttempts before a delivery is marked failed
+	MaxAttempts int
+	// BaseDelay is the first retry delay; it doubles on each attempt up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// PollInterval is how often due deliveries are picked up without a wake-up
+	PollInterval time.Duration
+	// Client sends deliveries (a client with DefaultTimeout when nil)
+	Client *http.Client
+}
+
+// Dispatcher delivers pending deliveries, one worker per webhook so a slow
+// endpoint only delays its own deliveries
+type Dispatcher struct {
+	store  Store
+	cfg    Config
+	client *http.Client
+	wake   chan struct{}
+	logger *slog.Logger
+	now    func() time.Time
+
+	mu      sync.Mutex
+	busy    map[int64]bool
+	workers sync.WaitGroup
+}
+
+// NewDispatcher creates a dispatcher. A nil logger falls back to slog.Default().
+func NewDispatcher(store Store, cfg Config, logger *slog.Logger) *Dispatcher {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.BaseDelay <= 0 {
+		cfg.BaseDelay = DefaultBaseDelay
+	}
+	if cfg.MaxDelay <= 0 {
+		cfg.MaxDelay = DefaultMaxDelay
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultPollInterval
+	}
+	client := cfg.Client
+	if client == nil {
+		client = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	return &Dispatcher{
+		store:  store,
+		cfg:    cfg,
+		client: client,
+		wake:   make(chan struct{}, 1),
+		logger: logger,
+		now:    time.Now,
+		busy:   make(map[int64]bool),
+	}
+}
+
+// Publish implements events.Publisher. Deliveries are already recorded with
+// the change, so the event only wakes Run instead of waiting for the next poll.
+func (d *Dispatcher) Publish(_ context.Context, _ events.Event) {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+		// A wake-up is already pending
+	}
+}
+
+// Run delivers due deliveries on every wake-up and poll until ctx is
+// cancelled, then waits for running workers
+func (d *Dispatcher) Run(ctx context.Context) {
+	d.logger.Info("Webhook dispatcher started", "maxAttempts", d.cfg.MaxAttempts, "pollInterval", d.cfg.PollInterval.String())
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.workers.Wait()
+			d.logger.Info("Webhook dispatcher stopped")
+			return
+		case <-d.wake:
+		case <-ticker.C:
+		}
+		if err := d.DeliverDue(ctx); err != nil {
+			d.logger.Error("Failed to deliver webhook deliveries", "error", err)
+		}
+	}
+}
+
+// DeliverDue starts a worker for every webhook with due deliveries and no
+// running worker. Each worker attempts its webhook's deliveries in order;
+// DeliverDue does not wait for them.
+func (d *Dispatcher) DeliverDue(ctx context.Context) error {
+	d.mu.Lock()
+	busy := make([]int64, 0, len(d.busy))
+	for id := range d.busy {
+		busy = append(busy, id)
+	}
+	d.mu.Unlock()
+
+	deliveries, err := d.store.GetDueWebhookDeliveries(ctx, d.now(), busy, dueBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var order []int64
+	byWebhook := make(map[int64][]models.WebhookDelivery)
+	for _, delivery := range deliveries {
+		if _, ok := byWebhook[delivery.WebhookID]; !ok {
+			order = append(order, delivery.WebhookID)
+		}
+		byWebhook[delivery.WebhookID] = append(byWebhook[delivery.WebhookID], delivery)
+	}
+
+	for _, webhookID := range order {
+		d.mu.Lock()
+		if d.busy[webhookID] {
+			d.mu.Unlock()
+			continue
+		}
+		d.busy[webhookID] = true
+		d.mu.Unlock()
+
+		d.workers.Add(1)
+		go d.deliver(ctx, webhookID, byWebhook[webhookID])
+	}
+	return nil
+}
+
+// deliver attempts the due deliveries of one webhook
+func (d *Dispatcher) deliver(ctx context.Context, webhookID int64, deliveries []models.WebhookDelivery) {
+	defer func() {
+		d.mu.Lock()
+		delete(d.busy, webhookID)
+		d.mu.Unlock()
+		d.workers.Done()
+	}()
+
+	webhook, err := d.store.GetWebhookByID(ctx, webhookID)
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			// Left pending and picked up again after restart
+			return
+		}
+		if err != nil || webhook.Disabled {
+			// Webhook deleted or disabled since the delivery was recorded
+			d.finish(ctx, &deliveries[i], models.WebhookDeliveryFailed, "webhook no longer active")
+			continue
+		}
+		d.attempt(ctx, webhook, &deliveries[i])
+	}
+}
+
+// SendTest synchronously delivers a webhook.test event to one webhook (no retries)
+// and returns the recorded delivery
+func (d *Dispatcher) SendTest(ctx context.Context, webhook *models.Webhook) (*models.WebhookDelivery, error) {
+	event := events.Event{
+		ID:         uuid.NewString(),
+		Type:       TestEventType,
+		Action:     "test",
+		OccurredAt: d.now().UTC(),
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	delivery := &models.WebhookDelivery{
+		WebhookID: webhook.ID,
+		EventID:   event.ID,
+		EventType: event.Type,
+		Payload:   payload,
+		Status:    models.WebhookDeliveryPending,
+	}
+	if err := d.store.CreateWebhookDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+
+	statusCode, sendErr := d.send(ctx, webhook, delivery)
+	delivery.Attempts++
+	delivery.ResponseStatus = statusCode
+	if sendErr != nil {
+		d.finish(ctx, delivery, models.WebhookDeliveryFailed, sendErr.Error())
+	} else {
+		d.finish(ctx, delivery, models.WebhookDeliverySucceeded, "")
+	}
+	return delivery, nil
+}
+
+// attempt sends a delivery and records the outcome, scheduling a retry on failure
+func (d *Dispatcher) attempt(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) {
+	statusCode, err := d.send(ctx, webhook, delivery)
+	delivery.Attempts++
+	delivery.ResponseStatus = statusCode
+
+	switch {
+	case err == nil:
+		d.finish(ctx, delivery, models.WebhookDeliverySucceeded, "")
+	case delivery.Attempts >= d.cfg.MaxAttempts:
+		d.logger.Warn("Webhook delivery failed permanently", "webhookId", webhook.ID, "deliveryId", delivery.ID, "attempts", delivery.Attempts, "error", err)
+		d.finish(ctx, delivery, models.WebhookDeliveryFailed, err.Error())
+	default:
+		next := d.now().Add(d.retryDelay(delivery.Attempts))
+		delivery.NextAttemptAt = &next
+		delivery.LastError = truncate(err.Error())
+		d.save(ctx, delivery)
+	}
+}
+
+// finish marks a delivery as succeeded or failed
+func (d *Dispatcher) finish(ctx context.Context, delivery *models.WebhookDelivery, status, lastError string) {
+	delivery.Status = status
+	delivery.NextAttemptAt = nil
+	delivery.LastError = nil
+	if lastError != "" {
+		delivery.LastError = truncate(lastError)
+	}
+	if status == models.WebhookDeliverySucceeded {
+		now := d.now()
+		delivery.DeliveredAt = &now
+	}
+	d.save(ctx, delivery)
+}
+
+func (d *Dispatcher) save(ctx context.Context, delivery *models.WebhookDelivery) {
+	if err := d.store.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		d.logger.Error("Failed to update webhook delivery", "deliveryId", delivery.ID, "error", err)
+	}
+}
+
+// send POSTs the signed payload. Any non-2xx response is a failure.
+func (d *Dispatcher) send(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (*int, error) {
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "portfolio-admin-api-webhooks")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderDelivery, delivery.EventID)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	statusCode := resp.StatusCode
+	if statusCode < 200 || statusCode > 299 {
+		return &statusCode, fmt.Errorf("unexpected status %d", statusCode)
+	}
+	return &statusCode, nil
+}
+
+// retryDelay returns the backoff before the next attempt: BaseDelay * 2^(attempts-1), capped at MaxDelay
+func (d *Dispatcher) retryDelay(attempts int) time.Duration {
+	delay := d.cfg.BaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= d.cfg.MaxDelay {
+			return d.cfg.MaxDelay
+		}
+	}
+	return delay
+}
+
+// Sign returns the signature header value for a payload: sha256=<hex HMAC of "timestamp.payload">.
+// Receivers recompute it with the shared secret and should reject stale timestamps.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func truncate(s string) *string {
+	if len(s) > maxErrorLength {
+		s = s[:maxErrorLength]
+	}
+	return &s
+}
@@ -0,0 +1,259 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GunarsK-portfolio/admin-api/internal/events"
+	"github.com/GunarsK-portfolio/admin-api/internal/models"
+)
+
+const testSecret = "0123456789abcdef0123" // #nosec G101 -- test data, not a real secret
+
+type fakeStore struct {
+	mu         sync.Mutex
+	webhooks   []models.Webhook
+	deliveries []*models.WebhookDelivery
+	excluded   [][]int64
+}
+
+func (s *fakeStore) GetWebhookByID(ctx context.Context, id int64) (*models.Webhook, error) {
+	for i := range s.webhooks {
+		if s.webhooks[i].ID == id {
+			return &s.webhooks[i], nil
+		}
+	}
+	return nil, context.Canceled
+}
+
+func (s *fakeStore) GetDueWebhookDeliveries(ctx context.Context, now time.Time, excludeWebhookIDs []int64, limit int) ([]models.WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.excluded = append(s.excluded, excludeWebhookIDs)
+	var due []models.WebhookDelivery
+	for _, d := range s.deliveries {
+		if d.Status == models.WebhookDeliveryPending && d.NextAttemptAt != nil && !d.NextAttemptAt.After(now) &&
+			!slices.Contains(excludeWebhookIDs, d.WebhookID) {
+			due = append(due, *d)
+		}
+	}
+	return due, nil
+}
+
+func (s *fakeStore) CreateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delivery.ID = int64(len(s.deliveries) + 1)
+	stored := *delivery
+	s.deliveries = append(s.deliveries, &stored)
+	return nil
+}
+
+func (s *fakeStore) UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	*s.deliveries[delivery.ID-1] = *delivery
+	return nil
+}
+
+// status returns the status of a delivery under the store lock
+func (s *fakeStore) status(id int64) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deliveries[id-1].Status
+}
+
+// enqueue records the deliveries of an event like the repository does
+func (s *fakeStore) enqueue(t *testing.T, event events.Event) {
+	t.Helper()
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("failed to encode event: %v", err)
+	}
+	for _, delivery := range events.NewDeliveries(s.webhooks, event, payload) {
+		_ = s.CreateWebhookDelivery(context.Background(), &delivery)
+	}
+}
+
+func newTestDispatcher(store Store, now time.Time) *Dispatcher {
+	d := NewDispatcher(store, Config{MaxAttempts: 3, BaseDelay: time.Minute, MaxDelay: 3 * time.Minute}, nil)
+	d.now = func() time.Time { return now }
+	return d
+}
+
+func TestDeliverDue_SignsAndDelivers(t *testing.T) {
+	var gotSignature, gotTimestamp string
+	var gotBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSignature = r.Header.Get(HeaderSignature)
+		gotTimestamp = r.Header.Get(HeaderTimestamp)
+		gotBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store := &fakeStore{webhooks: []models.Webhook{{ID: 1, URL: server.URL, Secret: testSecret, Events: []string{"portfolio.*"}}}}
+	store.enqueue(t, events.New(models.EntityPortfolioProject, events.ActionUpdated, 12, "/portfolio/projects/12"))
+	d := newTestDispatcher(store, time.Now())
+
+	if err := d.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	d.workers.Wait()
+
+	if status := store.status(1); status != models.WebhookDeliverySucceeded {
+		t.Errorf("delivery status = %s, want %s", status, models.WebhookDeliverySucceeded)
+	}
+	if want := Sign(testSecret, gotTimestamp, gotBody); gotSignature != want {
+		t.Errorf("signature = %s, want %s", gotSignature, want)
+	}
+}
+
+func TestDeliverDue_RetriesWithBackoffThenFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	event := events.New(models.EntitySkill, events.ActionCreated, 1, "")
+	now := event.OccurredAt
+	store := &fakeStore{webhooks: []models.Webhook{{ID: 1, URL: server.URL, Secret: testSecret, Events: []string{"*"}}}}
+	store.enqueue(t, event)
+	d := newTestDispatcher(store, now)
+	d.now = func() time.Time { return now }
+	deliverDue := func() {
+		_ = d.DeliverDue(context.Background())
+		d.workers.Wait()
+	}
+
+	deliverDue()
+	delivery := store.deliveries[0]
+	if delivery.Status != models.WebhookDeliveryPending || !delivery.NextAttemptAt.Equal(now.Add(time.Minute)) {
+		t.Fatalf("after first attempt: status = %s, next = %v, want pending in 1m", delivery.Status, delivery.NextAttemptAt)
+	}
+
+	// Second attempt doubles the delay
+	now = now.Add(time.Minute)
+	deliverDue()
+	if !delivery.NextAttemptAt.Equal(now.Add(2 * time.Minute)) {
+		t.Fatalf("after second attempt: next = %v, want %v", delivery.NextAttemptAt, now.Add(2*time.Minute))
+	}
+
+	// Third attempt reaches MaxAttempts
+	now = now.Add(2 * time.Minute)
+	deliverDue()
+	if delivery.Status != models.WebhookDeliveryFailed || delivery.Attempts != 3 {
+		t.Errorf("final status = %s after %d attempts, want failed after 3", delivery.Status, delivery.Attempts)
+	}
+	if delivery.ResponseStatus == nil || *delivery.ResponseStatus != http.StatusInternalServerError {
+		t.Errorf("response status = %v, want 500", delivery.ResponseStatus)
+	}
+}
+
+func TestDeliverDue_SlowWebhookDoesNotBlockOthers(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slow.Close()
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fast.Close()
+
+	store := &fakeStore{webhooks: []models.Webhook{
+		{ID: 1, URL: slow.URL, Secret: testSecret, Events: []string{"*"}},
+		{ID: 2, URL: fast.URL, Secret: testSecret, Events: []string{"*"}},
+	}}
+	store.enqueue(t, events.New(models.EntitySkill, events.ActionCreated, 1, ""))
+	d := newTestDispatcher(store, time.Now())
+
+	if err := d.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for store.status(2) != models.WebhookDeliverySucceeded {
+		if time.Now().After(deadline) {
+			t.Fatal("fast webhook not delivered within 1s while the slow one is pending")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// The busy webhook is skipped instead of being delivered twice
+	if err := d.DeliverDue(context.Background()); err != nil {
+		t.Fatalf("DeliverDue() error = %v", err)
+	}
+	if excluded := store.excluded[len(store.excluded)-1]; !slices.Equal(excluded, []int64{1}) {
+		t.Errorf("excluded webhooks = %v, want [1]", excluded)
+	}
+
+	close(release)
+	d.workers.Wait()
+	if status := store.status(1); status != models.WebhookDeliverySucceeded {
+		t.Errorf("slow delivery status = %s, want %s", status, models.WebhookDeliverySucceeded)
+	}
+}
+
+func TestRun_DeliversOnPublish(t *testing.T) {
+	delivered := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		delivered <- struct{}{}
+	}))
+	defer server.Close()
+
+	store := &fakeStore{webhooks: []models.Webhook{{ID: 1, URL: server.URL, Secret: testSecret, Events: []string{"*"}}}}
+	d := NewDispatcher(store, Config{PollInterval: time.Hour}, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go d.Run(ctx)
+
+	event := events.New(models.EntitySkill, events.ActionCreated, 1, "")
+	store.enqueue(t, event)
+	d.Publish(ctx, event)
+
+	select {
+	case <-delivered:
+	case <-time.After(time.Second):
+		t.Fatal("no delivery within 1s of Publish")
+	}
+}
+
+func TestRetryDelay_Capped(t *testing.T) {
+	d := newTestDispatcher(&fakeStore{}, time.Now())
+
+	for attempts, want := range map[int]time.Duration{1: time.Minute, 2: 2 * time.Minute, 3: 3 * time.Minute, 10: 3 * time.Minute} {
+		if got := d.retryDelay(attempts); got != want {
+			t.Errorf("retryDelay(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+func TestSendTest_RecordsFailedDelivery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(HeaderEvent) != TestEventType {
+			t.Errorf("event header = %s, want %s", r.Header.Get(HeaderEvent), TestEventType)
+		}
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer server.Close()
+
+	store := &fakeStore{}
+	d := newTestDispatcher(store, time.Now())
+
+	delivery, err := d.SendTest(context.Background(), &models.Webhook{ID: 1, URL: server.URL, Secret: testSecret})
+	if err != nil {
+		t.Fatalf("SendTest() error = %v", err)
+	}
+	if delivery.Status != models.WebhookDeliveryFailed || delivery.NextAttemptAt != nil {
+		t.Errorf("SendTest() delivery = %+v, want failed without retry", delivery)
+	}
+}